@@ -0,0 +1,4 @@
+data "dependencytrack_vulnerability" "example" {
+  source  = "NVD"
+  vuln_id = "CVE-2021-44228"
+}
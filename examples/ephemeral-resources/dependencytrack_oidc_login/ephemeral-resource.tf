@@ -0,0 +1,12 @@
+ephemeral "dependencytrack_oidc_login" "example" {
+  id_token = "eyJ..."
+}
+
+provider "dependencytrack" {
+  alias = "example"
+  host  = "http://localhost:8081"
+  auth = {
+    type   = "BEARER"
+    bearer = ephemeral.dependencytrack_oidc_login.example.token
+  }
+}
@@ -0,0 +1,22 @@
+resource "dependencytrack_team" "example" {
+  name = "Example"
+}
+
+# Generated for the run, and deleted afterwards, so only usable within the run, such as by a provider.
+ephemeral "dependencytrack_team_apikey" "example" {
+  team            = dependencytrack_team.example.id
+  comment         = "Example Comment"
+  revoke_on_close = true
+}
+
+provider "dependencytrack" {
+  alias = "example"
+  host  = "http://localhost:8081"
+  key   = ephemeral.dependencytrack_team_apikey.example.key
+}
+
+# Looks up an existing legacy API Key.
+ephemeral "dependencytrack_team_apikey" "legacy" {
+  team      = dependencytrack_team.example.id
+  public_id = "abcdefgh"
+}
@@ -0,0 +1,19 @@
+variable "password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+ephemeral "dependencytrack_user_login" "example" {
+  username = "admin"
+  password = var.password
+}
+
+provider "dependencytrack" {
+  alias = "example"
+  host  = "http://localhost:8081"
+  auth = {
+    type   = "BEARER"
+    bearer = ephemeral.dependencytrack_user_login.example.token
+  }
+}
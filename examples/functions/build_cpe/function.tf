@@ -0,0 +1,13 @@
+resource "dependencytrack_component" "example" {
+  project = dependencytrack_project.example.id
+  name    = "log4j-core"
+  version = "2.17.0"
+  hashes  = {}
+  # "cpe:2.3:a:apache:log4j:2.17.0:*:*:*:*:*:*:*"
+  cpe = provider::dependencytrack::build_cpe({
+    part    = "a"
+    vendor  = "apache"
+    product = "log4j"
+    version = "2.17.0"
+  })
+}
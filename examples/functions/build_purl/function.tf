@@ -0,0 +1,14 @@
+output "purl" {
+  # "pkg:npm/%40angular/core@16.0.0"
+  value = provider::dependencytrack::build_purl({
+    type      = "npm"
+    namespace = "@angular"
+    name      = "core"
+    version   = "16.0.0"
+  })
+}
+
+output "canonical" {
+  # "pkg:github/package-url/purl-spec"
+  value = provider::dependencytrack::build_purl(provider::dependencytrack::parse_purl("pkg:GitHub/Package-URL/Purl-Spec"))
+}
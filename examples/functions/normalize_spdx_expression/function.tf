@@ -0,0 +1,4 @@
+output "license" {
+  # "(MIT OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0"
+  value = provider::dependencytrack::normalize_spdx_expression("((MIT or Apache-2.0)) and GPL-2.0-only with Classpath-exception-2.0")
+}
@@ -0,0 +1,8 @@
+locals {
+  cpe = provider::dependencytrack::parse_cpe("cpe:2.3:a:apache:log4j:2.17.0:*:*:*:*:*:*:*")
+}
+
+output "product" {
+  # "log4j"
+  value = local.cpe.product
+}
@@ -0,0 +1,13 @@
+locals {
+  purl = provider::dependencytrack::parse_purl("pkg:maven/org.apache.logging.log4j/log4j-core@2.17.0?type=jar")
+}
+
+output "group" {
+  # "org.apache.logging.log4j"
+  value = local.purl.namespace
+}
+
+output "type" {
+  # "jar"
+  value = lookup(local.purl.qualifiers, "type", null)
+}
@@ -0,0 +1,4 @@
+# Run with `terraform query -generate-config-out=generated.tf` to generate config and import blocks.
+list "dependencytrack_policy" "all" {
+  provider = dependencytrack
+}
@@ -0,0 +1,13 @@
+# Run with `terraform query -generate-config-out=generated.tf` to generate config and import blocks.
+list "dependencytrack_project" "all" {
+  provider = dependencytrack
+}
+
+list "dependencytrack_project" "active_applications" {
+  provider = dependencytrack
+  config {
+    tag        = "production"
+    classifier = "APPLICATION"
+    active     = true
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = dependencytrack_acl_mapping.example
+  identity = {
+    team_id    = "51e49752-6039-404b-bd4d-02e5d624a934"
+    project_id = "c82d6f01-a7a4-41d6-9b03-4f06497f575b"
+  }
+}
@@ -0,0 +1 @@
+terraform import dependencytrack_analysis.example c82d6f01-a7a4-41d6-9b03-4f06497f575b/7c8a8e2c-a7e0-4e11-9b1c-3a5f2f7d2b41/0a6d9f4e-2b7c-4d3e-8f1a-5c9b7e3d1f20
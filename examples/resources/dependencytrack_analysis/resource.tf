@@ -0,0 +1,11 @@
+resource "dependencytrack_analysis" "example" {
+  project       = "c82d6f01-a7a4-41d6-9b03-4f06497f575b"
+  component     = "7c8a8e2c-a7e0-4e11-9b1c-3a5f2f7d2b41"
+  vulnerability = "0a6d9f4e-2b7c-4d3e-8f1a-5c9b7e3d1f20"
+  state         = "NOT_AFFECTED"
+  justification = "CODE_NOT_REACHABLE"
+  response      = "WILL_NOT_FIX"
+  details       = "JNDI lookups are disabled by configuration."
+  suppressed    = true
+  comment       = "Triaged in security review."
+}
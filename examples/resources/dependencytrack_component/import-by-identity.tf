@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_component.example
+  identity = {
+    id = "ad0eeb57-5169-42e1-a4e0-04dbd2e9e5a0"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = dependencytrack_component_property.example
+  identity = {
+    component_id = "ad0eeb57-5169-42e1-a4e0-04dbd2e9e5a0"
+    id           = "b1a70109-90d9-4dd2-bde6-5fa0e8cf9769"
+  }
+}
@@ -0,0 +1,31 @@
+# Migrating from several `dependencytrack_config_property` resources.
+# One is moved, and the others are removed from state without being destroyed,
+# as their values are now managed by `dependencytrack_config_properties`.
+resource "dependencytrack_config_properties" "migrated" {
+  properties = [
+    {
+      group = "general"
+      name  = "base.url"
+      value = "http://localhost:8000"
+      type  = "STRING"
+    },
+    {
+      group = "general"
+      name  = "badge.enabled"
+      value = "true"
+      type  = "BOOLEAN"
+    }
+  ]
+}
+
+moved {
+  from = dependencytrack_config_property.base_url
+  to   = dependencytrack_config_properties.migrated
+}
+
+removed {
+  from = dependencytrack_config_property.badge_enabled
+  lifecycle {
+    destroy = false
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = dependencytrack_config_property.example
+  identity = {
+    group = "general"
+    name  = "base.url"
+  }
+}
@@ -4,3 +4,18 @@ resource "dependencytrack_config_property" "example" {
   value = "http://localhost:8000"
   type  = "STRING"
 }
+
+# Requires Terraform 1.11+. Increment `value_wo_version` to send a new value.
+variable "smtp_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "dependencytrack_config_property" "example_write_only" {
+  group            = "email"
+  name             = "smtp.password"
+  value_wo         = var.smtp_password
+  value_wo_version = 1
+  type             = "ENCRYPTEDSTRING"
+}
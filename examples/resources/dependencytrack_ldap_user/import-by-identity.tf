@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_ldap_user.example
+  identity = {
+    username = "Example"
+  }
+}
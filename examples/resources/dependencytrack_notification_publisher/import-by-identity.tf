@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_notification_publisher.example
+  identity = {
+    id = "c575bdcd-a606-4ef8-8c51-5b3c8ab0416a"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_notification_rule.example
+  identity = {
+    id = "fdcff7ae-a0c0-4f54-9bb6-7bdb6c56c9fd"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = dependencytrack_notification_rule_project.example
+  identity = {
+    rule_id    = "fdcff7ae-a0c0-4f54-9bb6-7bdb6c56c9fd"
+    project_id = "c82d6f01-a7a4-41d6-9b03-4f06497f575b"
+  }
+}
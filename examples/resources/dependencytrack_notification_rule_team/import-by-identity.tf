@@ -0,0 +1,7 @@
+import {
+  to = dependencytrack_notification_rule_team.example
+  identity = {
+    rule_id = "fdcff7ae-a0c0-4f54-9bb6-7bdb6c56c9fd"
+    team_id = "51e49752-6039-404b-bd4d-02e5d624a934"
+  }
+}
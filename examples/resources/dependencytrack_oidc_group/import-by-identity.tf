@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_oidc_group.example
+  identity = {
+    id = "bc6ede57-2393-4d09-b0ed-db8c55338819"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_oidc_group_mapping.example
+  identity = {
+    id = "1362ea68-9443-45c8-834a-8e7bd5006254"
+  }
+}
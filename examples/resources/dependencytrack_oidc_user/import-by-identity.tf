@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_oidc_user.example
+  identity = {
+    username = "Example"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_policy.example
+  identity = {
+    id = "6a68f46f-c232-471e-8225-416fd16fd8b4"
+  }
+}
@@ -1 +1,3 @@
 terraform import dependencytrack_policy.example 6a68f46f-c232-471e-8225-416fd16fd8b4
+# By name.
+terraform import dependencytrack_policy.example "Example Policy"
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_policy_condition.example
+  identity = {
+    id = "81b6f3d1-d9ae-49d2-b687-fd1c7b783736"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_project.example
+  identity = {
+    id = "c82d6f01-a7a4-41d6-9b03-4f06497f575b"
+  }
+}
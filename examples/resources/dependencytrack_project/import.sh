@@ -1 +1,3 @@
 terraform import dependencytrack_project.example c82d6f01-a7a4-41d6-9b03-4f06497f575b
+# By name and version, or by name alone when only one version exists.
+terraform import dependencytrack_project.example "Example@1.0.0"
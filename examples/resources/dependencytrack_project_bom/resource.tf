@@ -0,0 +1,17 @@
+resource "dependencytrack_project" "example" {
+  name    = "Example"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project_bom" "example" {
+  project = dependencytrack_project.example.id
+  path    = "${path.module}/bom.json"
+  timeout = "10m"
+}
+
+resource "dependencytrack_project_bom" "auto_create" {
+  project_name    = "Example Service"
+  project_version = "2.0.0"
+  auto_create     = true
+  content         = file("${path.module}/service-bom.json")
+}
@@ -0,0 +1,8 @@
+import {
+  to = dependencytrack_project_property.example
+  identity = {
+    project_id = "c82d6f01-a7a4-41d6-9b03-4f06497f575b"
+    group      = "GroupName"
+    name       = "PropertyName"
+  }
+}
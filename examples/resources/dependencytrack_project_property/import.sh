@@ -1 +1,3 @@
 terraform import dependencytrack_project_property.example c82d6f01-a7a4-41d6-9b03-4f06497f575b/GroupName/PropertyName
+# By project name and version.
+terraform import dependencytrack_project_property.example "Example@1.0.0/GroupName/PropertyName"
@@ -8,3 +8,19 @@ resource "dependencytrack_project_property" "example" {
   group   = "GroupName"
   name    = "PropertyName"
 }
+
+# Requires Terraform 1.11+. Increment `value_wo_version` to send a new value.
+variable "project_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "dependencytrack_project_property" "example_write_only" {
+  project          = dependencytrack_project.example.id
+  group            = "GroupName"
+  name             = "SecretName"
+  value_wo         = var.project_secret
+  value_wo_version = 1
+  type             = "ENCRYPTEDSTRING"
+}
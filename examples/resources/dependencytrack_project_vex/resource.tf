@@ -0,0 +1,19 @@
+resource "dependencytrack_project" "example" {
+  name    = "Example"
+  version = "1.0.0"
+}
+
+resource "dependencytrack_project_bom" "example" {
+  project = dependencytrack_project.example.id
+  path    = "${path.module}/bom.json"
+}
+
+resource "dependencytrack_project_vex" "example" {
+  # Upload after the BOM, so that its findings exist to be analysed.
+  project = dependencytrack_project_bom.example.project
+  path    = "${path.module}/vex.json"
+}
+
+output "not_affected" {
+  value = [for analysis in dependencytrack_project_vex.example.analyses : analysis.vuln_id if analysis.state == "NOT_AFFECTED"]
+}
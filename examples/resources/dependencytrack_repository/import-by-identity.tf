@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_repository.example
+  identity = {
+    id = "ecc8e95f-6879-4d2c-9cc5-446543c611da"
+  }
+}
@@ -6,3 +6,21 @@ resource "dependencytrack_repository" "example" {
   username   = ""
   password   = ""
 }
+
+# Requires Terraform 1.11+. Increment `password_wo_version` to send a new password.
+variable "repository_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "dependencytrack_repository" "example_write_only" {
+  type                = "MAVEN"
+  identifier          = "internal-maven"
+  url                 = "https://maven.example.com"
+  enabled             = true
+  internal            = true
+  username            = "Example"
+  password_wo         = var.repository_password
+  password_wo_version = 1
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_tag.example
+  identity = {
+    name = "example_tag"
+  }
+}
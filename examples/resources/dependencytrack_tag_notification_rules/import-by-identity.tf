@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_tag_notification_rules.example
+  identity = {
+    tag = "example_tag"
+  }
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_tag_policies.example
+  identity = {
+    tag = "example_tag"
+  }
+}
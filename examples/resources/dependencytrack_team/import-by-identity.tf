@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_team.example
+  identity = {
+    id = "51e49752-6039-404b-bd4d-02e5d624a934"
+  }
+}
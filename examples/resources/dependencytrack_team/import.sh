@@ -1 +1,3 @@
 terraform import dependencytrack_team.example 51e49752-6039-404b-bd4d-02e5d624a934
+# By name.
+terraform import dependencytrack_team.example "Example Team"
@@ -0,0 +1,8 @@
+# API >=4.13 & Non-Legacy
+import {
+  to = dependencytrack_team_apikey.example
+  identity = {
+    team_id   = "51e49752-6039-404b-bd4d-02e5d624a934"
+    public_id = "xRJid7BI"
+  }
+}
@@ -0,0 +1,19 @@
+# Migrating from several `dependencytrack_team_permission` resources for the same Team.
+# One is moved, and the others are removed from state without being destroyed,
+# as their permissions are now managed by `dependencytrack_team_permissions`.
+resource "dependencytrack_team_permissions" "migrated" {
+  team        = dependencytrack_team.example.id
+  permissions = ["BOM_UPLOAD", "VIEW_PORTFOLIO"]
+}
+
+moved {
+  from = dependencytrack_team_permission.bom_upload
+  to   = dependencytrack_team_permissions.migrated
+}
+
+removed {
+  from = dependencytrack_team_permission.view_portfolio
+  lifecycle {
+    destroy = false
+  }
+}
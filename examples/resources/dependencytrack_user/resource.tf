@@ -4,3 +4,18 @@ resource "dependencytrack_user" "example" {
   email    = "Example_User@example.com"
   password = "Initial_User_Password"
 }
+
+# Requires Terraform 1.11+. Increment `password_wo_version` to set a new password.
+variable "user_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "dependencytrack_user" "example_write_only" {
+  username            = "Example_Write_Only"
+  fullname            = "Example User"
+  email               = "Example_Write_Only@example.com"
+  password_wo         = var.user_password
+  password_wo_version = 1
+}
@@ -0,0 +1 @@
+terraform import dependencytrack_violation_analysis.example 7c8a8e2c-a7e0-4e11-9b1c-3a5f2f7d2b41/3f4e6a8b-1c2d-4e5f-9a0b-7c6d5e4f3a21
@@ -0,0 +1,20 @@
+resource "dependencytrack_policy" "example" {
+  name      = "Forbidden Component"
+  operator  = "ANY"
+  violation = "FAIL"
+}
+
+resource "dependencytrack_policy_condition" "example" {
+  policy   = dependencytrack_policy.example.id
+  subject  = "COORDINATES"
+  operator = "MATCHES"
+  value    = jsonencode({ group = "*", name = "log4j-core", version = "*" })
+}
+
+resource "dependencytrack_violation_analysis" "example" {
+  component        = "7c8a8e2c-a7e0-4e11-9b1c-3a5f2f7d2b41"
+  policy_condition = dependencytrack_policy_condition.example.id
+  state            = "APPROVED"
+  suppressed       = true
+  comment          = "Approved by the architecture board."
+}
@@ -0,0 +1,6 @@
+import {
+  to = dependencytrack_vulnerability.example
+  identity = {
+    id = "3b2c9a4e-5d6f-4a7b-8c9d-0e1f2a3b4c5d"
+  }
+}
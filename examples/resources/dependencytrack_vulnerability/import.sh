@@ -0,0 +1,3 @@
+terraform import dependencytrack_vulnerability.example 3b2c9a4e-5d6f-4a7b-8c9d-0e1f2a3b4c5d
+# By internal vulnerability ID.
+terraform import dependencytrack_vulnerability.example "INT-2026-0001"
@@ -0,0 +1,22 @@
+resource "dependencytrack_vulnerability" "example" {
+  vuln_id        = "INT-2026-0001"
+  title          = "Deserialization of untrusted data in example-library"
+  description    = "Payloads received by the message listener are deserialized without an allow list."
+  recommendation = "Upgrade to 1.4.2 or later."
+  references     = "* [Advisory](https://security.example.com/advisories/INT-2026-0001)"
+  cvss_v3_vector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+  cwes           = [502]
+  affected_components = [
+    {
+      type                    = "PURL"
+      identity                = "pkg:maven/com.example/example-library"
+      version_start_including = "1.0.0"
+      version_end_excluding   = "1.4.2"
+    },
+    {
+      type     = "CPE"
+      identity = "cpe:2.3:a:example:example-library:*:*:*:*:*:*:*:*"
+      version  = "0.9.0"
+    },
+  ]
+}
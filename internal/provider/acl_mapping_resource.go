@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &aclMappingResource{}
 	_ resource.ResourceWithConfigure   = &aclMappingResource{}
 	_ resource.ResourceWithImportState = &aclMappingResource{}
+	_ resource.ResourceWithIdentity    = &aclMappingResource{}
 )
 
 type (
@@ -71,6 +72,15 @@ func (*aclMappingResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+var aclMappingIdentity = resourceIdentity{
+	{Name: "team_id", State: path.Root("team"), Description: "UUID of the Team."},
+	{Name: "project_id", State: path.Root("project"), Description: "UUID of the Project."},
+}
+
+func (*aclMappingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = aclMappingIdentity.Schema()
+}
+
 func (r *aclMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan aclMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -118,6 +128,7 @@ func (r *aclMappingResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(aclMappingIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Project ACL Mapping", map[string]any{
 		"id":      plan.ID.ValueString(),
 		"project": plan.Project.ValueString(),
@@ -126,6 +137,7 @@ func (r *aclMappingResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *aclMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(aclMappingIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state aclMappingResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -156,7 +168,9 @@ func (r *aclMappingResource) Read(ctx context.Context, req resource.ReadRequest,
 	}, func(project dtrack.Project) bool {
 		return project.UUID == projectID
 	})
-
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": state.ID.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get ACL mapping within Read",
@@ -217,6 +231,7 @@ func (*aclMappingResource) Update(ctx context.Context, req resource.UpdateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(aclMappingIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Project ACL Mapping", map[string]any{
 		"id":      plan.ID.ValueString(),
 		"project": plan.Project.ValueString(),
@@ -268,11 +283,16 @@ func (r *aclMappingResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (*aclMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := aclMappingIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Within Import, unexpected id",
-			"Expected id in format <Team UUID>/<Project UUID>. Received "+req.ID,
+			"Expected id in format <Team UUID>/<Project UUID>. Received "+importID,
 		)
 		return
 	}
@@ -0,0 +1,450 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Comment appended to the audit trail of an Analysis, when no comment is configured.
+	DefaultAnalysisComment = "Updated by Terraform."
+)
+
+var (
+	_ resource.Resource                = &analysisResource{}
+	_ resource.ResourceWithConfigure   = &analysisResource{}
+	_ resource.ResourceWithImportState = &analysisResource{}
+	_ resource.ResourceWithIdentity    = &analysisResource{}
+)
+
+type (
+	analysisResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	analysisResourceModel struct {
+		Project       types.String `tfsdk:"project"`
+		Component     types.String `tfsdk:"component"`
+		Vulnerability types.String `tfsdk:"vulnerability"`
+		State         types.String `tfsdk:"state"`
+		Justification types.String `tfsdk:"justification"`
+		Response      types.String `tfsdk:"response"`
+		Details       types.String `tfsdk:"details"`
+		Suppressed    types.Bool   `tfsdk:"suppressed"`
+		Comment       types.String `tfsdk:"comment"`
+	}
+
+	analysisKey struct {
+		Project       uuid.UUID
+		Component     uuid.UUID
+		Vulnerability uuid.UUID
+	}
+)
+
+func NewAnalysisResource() resource.Resource {
+	return &analysisResource{}
+}
+
+func (*analysisResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_analysis"
+}
+
+func (*analysisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Analysis of a finding, being a Vulnerability of a Component within a Project. " +
+			"Each change appends 'comment' to the audit trail of the Analysis. " +
+			"Destroying this resource resets the Analysis to 'NOT_SET', and unsuppresses the finding.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "UUID for the Project of the finding.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"component": schema.StringAttribute{
+				Description: "UUID for the Component of the finding.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vulnerability": schema.StringAttribute{
+				Description: "UUID for the Vulnerability of the finding.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the Analysis. Supports " + describeValues(analysisStateValues) + ".",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(analysisStateValues...)},
+			},
+			"justification": schema.StringAttribute{
+				Description: "Justification of the Analysis, for 'NOT_AFFECTED'. Defaults to \"NOT_SET\". " +
+					"Supports " + describeValues(analysisJustificationValues) + ".",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(dtrack.AnalysisJustificationNotSet)),
+				Validators: []validator.String{stringvalidator.OneOf(analysisJustificationValues...)},
+			},
+			"response": schema.StringAttribute{
+				Description: "Vendor response of the Analysis. Defaults to \"NOT_SET\". " +
+					"Supports " + describeValues(analysisResponseValues) + ".",
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(dtrack.AnalysisResponseNotSet)),
+				Validators: []validator.String{stringvalidator.OneOf(analysisResponseValues...)},
+			},
+			"details": schema.StringAttribute{
+				Description: "Details of the Analysis. Once set, DependencyTrack does not support clearing details, only replacing them.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"suppressed": schema.BoolAttribute{
+				Description: "Whether the finding is suppressed. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment to append to the audit trail of the Analysis, on each change. " +
+					"Defaults to \"" + DefaultAnalysisComment + "\".",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(DefaultAnalysisComment),
+			},
+		},
+	}
+}
+
+var analysisIdentity = resourceIdentity{
+	{Name: "project_id", State: path.Root("project"), Description: "UUID of the Project."},
+	{Name: "component_id", State: path.Root("component"), Description: "UUID of the Component."},
+	{Name: "vulnerability_id", State: path.Root("vulnerability"), Description: "UUID of the Vulnerability."},
+}
+
+func (*analysisResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = analysisIdentity.Schema()
+}
+
+func (r *analysisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan analysisResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key, diags := analysisKeyFromModel(plan, LifecycleCreate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Analysis", map[string]any{
+		"project":       key.Project.String(),
+		"component":     key.Component.String(),
+		"vulnerability": key.Vulnerability.String(),
+		"state":         plan.State.ValueString(),
+	})
+	analysis, err := r.client.Analysis.Create(ctx, analysisRequestFromModel(key, plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Create, unable to create analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	plan = analysisModelFromAnalysis(key, analysis, plan.Comment)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(analysisIdentity.Set(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, "Created Analysis", map[string]any{
+		"project":       plan.Project.ValueString(),
+		"component":     plan.Component.ValueString(),
+		"vulnerability": plan.Vulnerability.ValueString(),
+		"state":         plan.State.ValueString(),
+	})
+}
+
+func (r *analysisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(analysisIdentity.Set(ctx, req.State, resp.Identity)...)
+	var state analysisResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key, diags := analysisKeyFromModel(state, LifecycleRead)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Analysis", map[string]any{
+		"project":       key.Project.String(),
+		"component":     key.Component.String(),
+		"vulnerability": key.Vulnerability.String(),
+	})
+	analysis, err := r.client.Analysis.Get(ctx, key.Component, key.Project, key.Vulnerability)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{
+		"project":       key.Project.String(),
+		"component":     key.Component.String(),
+		"vulnerability": key.Vulnerability.String(),
+	}) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Read, unable to retrieve analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	state = analysisModelFromAnalysis(key, analysis, state.Comment)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Read Analysis", map[string]any{
+		"project":       state.Project.ValueString(),
+		"component":     state.Component.ValueString(),
+		"vulnerability": state.Vulnerability.ValueString(),
+		"state":         state.State.ValueString(),
+	})
+}
+
+func (r *analysisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan analysisResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key, diags := analysisKeyFromModel(plan, LifecycleUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Analysis", map[string]any{
+		"project":       key.Project.String(),
+		"component":     key.Component.String(),
+		"vulnerability": key.Vulnerability.String(),
+		"state":         plan.State.ValueString(),
+	})
+	analysis, err := r.client.Analysis.Create(ctx, analysisRequestFromModel(key, plan))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Update, unable to update analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	plan = analysisModelFromAnalysis(key, analysis, plan.Comment)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(analysisIdentity.Set(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, "Updated Analysis", map[string]any{
+		"project":       plan.Project.ValueString(),
+		"component":     plan.Component.ValueString(),
+		"vulnerability": plan.Vulnerability.ValueString(),
+		"state":         plan.State.ValueString(),
+	})
+}
+
+func (r *analysisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state analysisResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	key, diags := analysisKeyFromModel(state, LifecycleDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Analysis", map[string]any{
+		"project":       key.Project.String(),
+		"component":     key.Component.String(),
+		"vulnerability": key.Vulnerability.String(),
+	})
+	// DependencyTrack does not support deleting an Analysis, so it is reset instead.
+	suppressed := false
+	_, err := r.client.Analysis.Create(ctx, dtrack.AnalysisRequest{
+		Project:       key.Project,
+		Component:     key.Component,
+		Vulnerability: key.Vulnerability,
+		Comment:       "Removed from Terraform.",
+		State:         dtrack.AnalysisStateNotSet,
+		Justification: dtrack.AnalysisJustificationNotSet,
+		Response:      dtrack.AnalysisResponseNotSet,
+		Details:       "",
+		Suppressed:    &suppressed,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Delete, unable to reset analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted Analysis", map[string]any{
+		"project":       state.Project.ValueString(),
+		"component":     state.Component.ValueString(),
+		"vulnerability": state.Vulnerability.ValueString(),
+	})
+}
+
+func (r *analysisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := analysisIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected import id",
+			"Expected id in format <ProjectID>/<ComponentID>/<VulnerabilityID>. Received "+importID,
+		)
+		return
+	}
+	ids := make([]uuid.UUID, 0, len(idParts))
+	for index, name := range []string{"ProjectID", "ComponentID", "VulnerabilityID"} {
+		id, err := uuid.Parse(idParts[index])
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Within Import, unable to parse "+name+" as UUID",
+				"Error from: "+err.Error(),
+			)
+			return
+		}
+		ids = append(ids, id)
+	}
+	key := analysisKey{Project: ids[0], Component: ids[1], Vulnerability: ids[2]}
+
+	analysis, err := r.client.Analysis.Get(ctx, key.Component, key.Project, key.Vulnerability)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to retrieve analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	state := analysisModelFromAnalysis(key, analysis, types.StringValue(DefaultAnalysisComment))
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Imported Analysis", map[string]any{
+		"project":       state.Project.ValueString(),
+		"component":     state.Component.ValueString(),
+		"vulnerability": state.Vulnerability.ValueString(),
+		"state":         state.State.ValueString(),
+	})
+}
+
+func (r *analysisResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
+
+func analysisKeyFromModel(model analysisResourceModel, lifecycle LifecycleAction) (analysisKey, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	key := analysisKey{Project: uuid.Nil, Component: uuid.Nil, Vulnerability: uuid.Nil}
+	for _, field := range []struct {
+		value  types.String
+		name   string
+		target *uuid.UUID
+	}{
+		{value: model.Project, name: "project", target: &key.Project},
+		{value: model.Component, name: "component", target: &key.Component},
+		{value: model.Vulnerability, name: "vulnerability", target: &key.Vulnerability},
+	} {
+		id, diagnostic := TryParseUUID(field.value, lifecycle, path.Root(field.name))
+		if diagnostic != nil {
+			diags.Append(diagnostic)
+			continue
+		}
+		*field.target = id
+	}
+	return key, diags
+}
+
+func analysisRequestFromModel(key analysisKey, model analysisResourceModel) dtrack.AnalysisRequest {
+	return dtrack.AnalysisRequest{
+		Project:       key.Project,
+		Component:     key.Component,
+		Vulnerability: key.Vulnerability,
+		Comment:       model.Comment.ValueString(),
+		State:         dtrack.AnalysisState(model.State.ValueString()),
+		Justification: dtrack.AnalysisJustification(model.Justification.ValueString()),
+		Response:      dtrack.AnalysisResponse(model.Response.ValueString()),
+		Details:       model.Details.ValueString(),
+		Suppressed:    model.Suppressed.ValueBoolPointer(),
+	}
+}
+
+func analysisModelFromAnalysis(key analysisKey, analysis dtrack.Analysis, comment types.String) analysisResourceModel {
+	return analysisResourceModel{
+		Project:       types.StringValue(key.Project.String()),
+		Component:     types.StringValue(key.Component.String()),
+		Vulnerability: types.StringValue(key.Vulnerability.String()),
+		// DependencyTrack omits unset values, rather than returning 'NOT_SET'.
+		State:         types.StringValue(cmp.Or(string(analysis.State), string(dtrack.AnalysisStateNotSet))),
+		Justification: types.StringValue(cmp.Or(string(analysis.Justification), string(dtrack.AnalysisJustificationNotSet))),
+		Response:      types.StringValue(cmp.Or(string(analysis.Response), string(dtrack.AnalysisResponseNotSet))),
+		Details:       types.StringValue(analysis.Details),
+		Suppressed:    types.BoolValue(analysis.Suppressed),
+		Comment:       comment,
+	}
+}
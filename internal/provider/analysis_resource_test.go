@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAnalysisModelFromAnalysis(t *testing.T) {
+	key := analysisKey{
+		Project:       uuid.MustParse("c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Component:     uuid.MustParse("d82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Vulnerability: uuid.MustParse("e82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+	}
+	{
+		model := analysisModelFromAnalysis(key, dtrack.Analysis{
+			Comments:      nil,
+			State:         dtrack.AnalysisStateNotAffected,
+			Justification: dtrack.AnalysisJustificationCodeNotReachable,
+			Response:      dtrack.AnalysisResponseWillNotFix,
+			Details:       "Details",
+			Suppressed:    true,
+		}, types.StringValue("Comment"))
+		requireEqual(t, model.Project.ValueString(), key.Project.String())
+		requireEqual(t, model.Component.ValueString(), key.Component.String())
+		requireEqual(t, model.Vulnerability.ValueString(), key.Vulnerability.String())
+		requireEqual(t, model.State.ValueString(), "NOT_AFFECTED")
+		requireEqual(t, model.Justification.ValueString(), "CODE_NOT_REACHABLE")
+		requireEqual(t, model.Response.ValueString(), "WILL_NOT_FIX")
+		requireEqual(t, model.Details.ValueString(), "Details")
+		requireEqual(t, model.Suppressed.ValueBool(), true)
+		requireEqual(t, model.Comment.ValueString(), "Comment")
+
+		req := analysisRequestFromModel(key, model)
+		requireEqual(t, req.Project.String(), key.Project.String())
+		requireEqual(t, string(req.State), "NOT_AFFECTED")
+		requireEqual(t, req.Comment, "Comment")
+		requireEqual(t, *req.Suppressed, true)
+	}
+	{
+		model := analysisModelFromAnalysis(key, dtrack.Analysis{}, types.StringValue(DefaultAnalysisComment))
+		requireEqual(t, model.State.ValueString(), "NOT_SET")
+		requireEqual(t, model.Justification.ValueString(), "NOT_SET")
+		requireEqual(t, model.Response.ValueString(), "NOT_SET")
+		requireEqual(t, model.Suppressed.ValueBool(), false)
+	}
+}
+
+func TestAnalysisKeyFromModel(t *testing.T) {
+	model := analysisModelFromAnalysis(analysisKey{
+		Project:       uuid.MustParse("c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Component:     uuid.MustParse("d82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Vulnerability: uuid.MustParse("e82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+	}, dtrack.Analysis{}, types.StringNull())
+	{
+		key, diags := analysisKeyFromModel(model, LifecycleRead)
+		requireEqual(t, diags.HasError(), false)
+		requireEqual(t, key.Vulnerability.String(), "e82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	}
+	{
+		model.Component = types.StringValue("not-a-uuid")
+		_, diags := analysisKeyFromModel(model, LifecycleRead)
+		requireEqual(t, diags.ErrorsCount(), 1)
+	}
+}
@@ -0,0 +1,152 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+const (
+	// VulnerabilitySourceInternal is the source of vulnerabilities managed within DependencyTrack.
+	VulnerabilitySourceInternal = "INTERNAL"
+)
+
+type (
+	// Client for DependencyTrack API endpoints which client-go does not support.
+	// Shares the HTTP Client of client-go, which applies authentication, retries and logging.
+	apiClient struct {
+		httpClient *http.Client
+		baseURL    *url.URL
+	}
+
+	// Vulnerability as sent to and returned from DependencyTrack, including the affected components,
+	// which client-go omits.
+	apiVulnerability struct {
+		UUID               *uuid.UUID                  `json:"uuid,omitempty"`
+		VulnID             string                      `json:"vulnId,omitempty"`
+		Source             string                      `json:"source"`
+		Title              string                      `json:"title,omitempty"`
+		Description        string                      `json:"description,omitempty"`
+		Recommendation     string                      `json:"recommendation,omitempty"`
+		References         string                      `json:"references,omitempty"`
+		Severity           string                      `json:"severity,omitempty"`
+		CVSSV2Vector       string                      `json:"cvssV2Vector,omitempty"`
+		CVSSV2BaseScore    *float64                    `json:"cvssV2BaseScore,omitempty"`
+		CVSSV3Vector       string                      `json:"cvssV3Vector,omitempty"`
+		CVSSV3BaseScore    *float64                    `json:"cvssV3BaseScore,omitempty"`
+		CWEs               []dtrack.CWE                `json:"cwes"`
+		AffectedComponents []apiAffectedComponent      `json:"affectedComponents"`
+		Aliases            []dtrack.VulnerabilityAlias `json:"aliases,omitempty"`
+	}
+
+	apiAffectedComponent struct {
+		IdentityType          string `json:"identityType"`
+		Identity              string `json:"identity"`
+		VersionType           string `json:"versionType"`
+		Version               string `json:"version,omitempty"`
+		VersionStartIncluding string `json:"versionStartIncluding,omitempty"`
+		VersionStartExcluding string `json:"versionStartExcluding,omitempty"`
+		VersionEndIncluding   string `json:"versionEndIncluding,omitempty"`
+		VersionEndExcluding   string `json:"versionEndExcluding,omitempty"`
+	}
+)
+
+func newAPIClient(httpClient *http.Client, client *dtrack.Client) apiClient {
+	return apiClient{
+		httpClient: httpClient,
+		baseURL:    client.BaseURL(),
+	}
+}
+
+// Performs a request with body encoded as JSON, when not nil, decoding the response into out, when not nil.
+// When out is a *string, the response is requested, and read, as plain text.
+// Unsuccessful responses are returned as *dtrack.APIError, for consistency with client-go.
+func (c apiClient) do(ctx context.Context, method, path string, body, out any) error {
+	target, err := c.baseURL.Parse(path)
+	if err != nil {
+		return err
+	}
+	var reader io.Reader
+	if body != nil {
+		content, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		reader = bytes.NewReader(content)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), reader)
+	if err != nil {
+		return err
+	}
+	text, isText := out.(*string)
+	if isText {
+		req.Header.Set("Accept", "text/plain")
+	} else {
+		req.Header.Set("Accept", "application/json")
+	}
+	req.Header.Set("User-Agent", dtrack.DefaultUserAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		message, _ := io.ReadAll(res.Body)
+		return &dtrack.APIError{StatusCode: res.StatusCode, Message: string(message)}
+	}
+	if out == nil {
+		return nil
+	}
+	if isText {
+		content, readErr := io.ReadAll(res.Body)
+		if readErr != nil {
+			return fmt.Errorf("unable to read response from %s %s: %w", method, path, readErr)
+		}
+		*text = string(content)
+		return nil
+	}
+	if err = json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("unable to decode response from %s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+func (c apiClient) GetVulnerability(ctx context.Context, vulnUUID uuid.UUID) (vulnerability apiVulnerability, err error) {
+	err = c.do(ctx, http.MethodGet, "api/v1/vulnerability/"+vulnUUID.String(), nil, &vulnerability)
+	return
+}
+
+func (c apiClient) GetVulnerabilityBySource(ctx context.Context, source, vulnID string) (vulnerability apiVulnerability, err error) {
+	err = c.do(ctx, http.MethodGet, "api/v1/vulnerability/source/"+url.PathEscape(source)+"/vuln/"+url.PathEscape(vulnID), nil, &vulnerability)
+	return
+}
+
+// Generates an identifier for an internal Vulnerability, such as `INT-1234-5678`.
+func (c apiClient) GenerateVulnerabilityID(ctx context.Context) (vulnID string, err error) {
+	err = c.do(ctx, http.MethodGet, "api/v1/vulnerability/vulnId", nil, &vulnID)
+	return
+}
+
+func (c apiClient) CreateVulnerability(ctx context.Context, vulnerability apiVulnerability) (created apiVulnerability, err error) {
+	err = c.do(ctx, http.MethodPut, "api/v1/vulnerability", vulnerability, &created)
+	return
+}
+
+func (c apiClient) UpdateVulnerability(ctx context.Context, vulnerability apiVulnerability) (updated apiVulnerability, err error) {
+	err = c.do(ctx, http.MethodPost, "api/v1/vulnerability", vulnerability, &updated)
+	return
+}
+
+func (c apiClient) DeleteVulnerability(ctx context.Context, vulnUUID uuid.UUID) error {
+	return c.do(ctx, http.MethodDelete, "api/v1/vulnerability/"+vulnUUID.String(), nil, nil)
+}
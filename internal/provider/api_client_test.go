@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+func TestAPIClientVulnerability(t *testing.T) {
+	var received apiVulnerability
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/version":
+			_, _ = w.Write([]byte(`{"version":"4.13.0"}`))
+		case "GET /api/v1/vulnerability/vulnId":
+			if r.Header.Get("Accept") != "text/plain" {
+				w.WriteHeader(http.StatusNotAcceptable)
+				return
+			}
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("INT-1234-5678"))
+		case "PUT /api/v1/vulnerability", "POST /api/v1/vulnerability":
+			if r.Header.Get("Content-Type") != "application/json" {
+				w.WriteHeader(http.StatusUnsupportedMediaType)
+				return
+			}
+			body, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(body, &received)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"uuid":"c82d6f01-a7a4-41d6-9b03-4f06497f575b","vulnId":"INT-001","source":"INTERNAL"}`))
+		case "GET /api/v1/vulnerability/c82d6f01-a7a4-41d6-9b03-4f06497f575b",
+			"GET /api/v1/vulnerability/source/INTERNAL/vuln/INT-001":
+			_, _ = w.Write([]byte(`{
+				"uuid":"c82d6f01-a7a4-41d6-9b03-4f06497f575b","vulnId":"INT-001","source":"INTERNAL",
+				"severity":"CRITICAL","cvssV3Vector":"CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H","cvssV3BaseScore":9.8,
+				"cwes":[{"cweId":79,"name":"Cross-site Scripting"}],
+				"affectedComponents":[{"identityType":"PURL","identity":"pkg:maven/com.example/lib","versionType":"RANGE","versionEndExcluding":"1.2.0"}]
+			}`))
+		case "DELETE /api/v1/vulnerability/c82d6f01-a7a4-41d6-9b03-4f06497f575b":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("The vulnerability could not be found."))
+		}
+	}))
+	defer server.Close()
+	client, err := dtrack.NewClient(server.URL)
+	requireNoError(t, err)
+	api := newAPIClient(server.Client(), client)
+	id := uuid.MustParse("c82d6f01-a7a4-41d6-9b03-4f06497f575b")
+
+	vulnID, err := api.GenerateVulnerabilityID(t.Context())
+	requireNoError(t, err)
+	requireEqual(t, vulnID, "INT-1234-5678")
+
+	created, err := api.CreateVulnerability(t.Context(), apiVulnerability{
+		UUID:               nil,
+		VulnID:             "INT-001",
+		Source:             VulnerabilitySourceInternal,
+		Title:              "Title",
+		Description:        "",
+		Recommendation:     "",
+		References:         "",
+		Severity:           "",
+		CVSSV2Vector:       "",
+		CVSSV2BaseScore:    nil,
+		CVSSV3Vector:       "",
+		CVSSV3BaseScore:    nil,
+		CWEs:               []dtrack.CWE{},
+		AffectedComponents: []apiAffectedComponent{},
+		Aliases:            nil,
+	})
+	requireNoError(t, err)
+	requireEqual(t, created.UUID.String(), id.String())
+	requireEqual(t, received.VulnID, "INT-001")
+	requireEqual(t, received.Title, "Title")
+	requireEqual(t, received.CWEs != nil, true)
+
+	received.UUID = &id
+	_, err = api.UpdateVulnerability(t.Context(), received)
+	requireNoError(t, err)
+	requireEqual(t, received.UUID.String(), id.String())
+
+	vulnerability, err := api.GetVulnerability(t.Context(), id)
+	requireNoError(t, err)
+	requireEqual(t, vulnerability.Severity, "CRITICAL")
+	requireEqual(t, *vulnerability.CVSSV3BaseScore, 9.8)
+	requireNil(t, vulnerability.CVSSV2BaseScore)
+	requireEqual(t, len(vulnerability.CWEs), 1)
+	requireEqual(t, vulnerability.CWEs[0].ID, 79)
+	requireEqual(t, len(vulnerability.AffectedComponents), 1)
+	requireEqual(t, vulnerability.AffectedComponents[0].VersionEndExcluding, "1.2.0")
+
+	vulnerability, err = api.GetVulnerabilityBySource(t.Context(), VulnerabilitySourceInternal, "INT-001")
+	requireNoError(t, err)
+	requireEqual(t, vulnerability.UUID.String(), id.String())
+
+	_, err = api.GetVulnerabilityBySource(t.Context(), VulnerabilitySourceInternal, "INT-002")
+	requireError(t, err, `^The vulnerability could not be found\. \(status: 404\)$`)
+	requireEqual(t, IsNotFound(err), true)
+
+	requireNoError(t, api.DeleteVulnerability(t.Context(), id))
+}
@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &buildCPEFunction{}
+)
+
+type (
+	buildCPEFunction struct{}
+)
+
+func NewBuildCPEFunction() function.Function {
+	return &buildCPEFunction{}
+}
+
+func (*buildCPEFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_cpe"
+}
+
+func (*buildCPEFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a CPE 2.3 formatted string from its attributes.",
+		Description: "Build a Common Platform Enumeration 2.3 formatted string, as specified by NISTIR 7695, from its attributes. " +
+			"Special characters within attribute values are escaped. " +
+			"Attributes which are omitted, null, or empty are '" + CPEAny + "'. " +
+			"Accepts the result of 'parse_cpe'.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "attributes",
+				Description: "Object of string attributes, of 'part', being one of 'a', 'o' or 'h', " +
+					"and any of 'vendor', 'product', 'version', 'update', 'edition', 'language', " +
+					"'sw_edition', 'target_sw', 'target_hw' and 'other'.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (*buildCPEFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var argument types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &argument)
+	if resp.Error != nil {
+		return
+	}
+	values, err := readFunctionObject(ctx, argument, cpeAttributeNames)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid CPE attributes: "+err.Error())
+		return
+	}
+	attributes := make(map[string]string, len(cpeAttributeNames))
+	for _, name := range cpeAttributeNames {
+		attributes[name], err = readFunctionString(values, name)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "Invalid CPE attributes: "+err.Error())
+			return
+		}
+	}
+	cpe, err := formatCPE(attributes)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid CPE attributes: "+err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, cpe)
+}
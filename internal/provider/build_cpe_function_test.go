@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBuildCPEFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::build_cpe({ part = "a", vendor = "example", product = "foo:bar", version = "1.0" })
+}
+output "round_trip" {
+	value = provider::dependencytrack::build_cpe(provider::dependencytrack::parse_cpe("cpe:/o:linux:linux_kernel:5.10"))
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact(`cpe:2.3:a:example:foo\:bar:1.0:*:*:*:*:*:*:*`)),
+					statecheck.ExpectKnownOutputValue("round_trip", knownvalue.StringExact("cpe:2.3:o:linux:linux_kernel:5.10:*:*:*:*:*:*:*")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::build_cpe({ part = "x", vendor = "example" })
+}
+`,
+				ExpectError: regexp.MustCompile(`invalid part 'x'`),
+			},
+		},
+	})
+}
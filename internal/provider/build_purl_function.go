@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &buildPURLFunction{}
+)
+
+type (
+	buildPURLFunction struct{}
+)
+
+func NewBuildPURLFunction() function.Function {
+	return &buildPURLFunction{}
+}
+
+func (*buildPURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "build_purl"
+}
+
+func (*buildPURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Build a canonical Package URL from its components.",
+		Description: "Build a Package URL, as specified by https://github.com/package-url/purl-spec, from its components. " +
+			"Components are normalised as the spec defines for known types, percent-encoded, and qualifiers sorted by key. " +
+			"Only 'type' and 'name' are required; other components may be omitted, null, or empty. " +
+			"Accepts the result of 'parse_purl'.",
+		Parameters: []function.Parameter{
+			function.DynamicParameter{
+				Name: "components",
+				Description: "Object of 'type', 'namespace', 'name', 'version', 'subpath', all strings, " +
+					"and 'qualifiers', an object or map of strings.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (*buildPURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var components types.Dynamic
+	resp.Error = req.Arguments.Get(ctx, &components)
+	if resp.Error != nil {
+		return
+	}
+	attributes, err := readFunctionObject(ctx, components, []string{"type", "namespace", "name", "version", "qualifiers", "subpath"})
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Package URL components: "+err.Error())
+		return
+	}
+	purl := packageURL{Type: "", Namespace: "", Name: "", Version: "", Qualifiers: nil, Subpath: ""}
+	for name, target := range map[string]*string{
+		"type":      &purl.Type,
+		"namespace": &purl.Namespace,
+		"name":      &purl.Name,
+		"version":   &purl.Version,
+		"subpath":   &purl.Subpath,
+	} {
+		*target, err = readFunctionString(attributes, name)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, "Invalid Package URL components: "+err.Error())
+			return
+		}
+	}
+	purl.Qualifiers, err = readFunctionStringMap(attributes, "qualifiers")
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Package URL components: "+err.Error())
+		return
+	}
+	purl, err = purl.normalize()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Package URL components: "+err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, purl.String())
+}
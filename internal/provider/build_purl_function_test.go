@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccBuildPURLFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::build_purl({
+		type = "npm"
+		namespace = "@angular"
+		name = "core"
+		version = "16.0.0"
+		qualifiers = { b = "2", a = "1" }
+	})
+}
+output "round_trip" {
+	value = provider::dependencytrack::build_purl(provider::dependencytrack::parse_purl("pkg:GitHub/Package-URL/Purl-Spec"))
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("pkg:npm/%40angular/core@16.0.0?a=1&b=2")),
+					statecheck.ExpectKnownOutputValue("round_trip", knownvalue.StringExact("pkg:github/package-url/purl-spec")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::build_purl({ type = "maven", name = "artifact" })
+}
+`,
+				ExpectError: regexp.MustCompile(`namespace is required for type 'maven'`),
+			},
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::build_purl({ type = "npm", name = "core", versoin = "1.0" })
+}
+`,
+				ExpectError: regexp.MustCompile(`unexpected attribute 'versoin'`),
+			},
+		},
+	})
+}
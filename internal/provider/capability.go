@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	// Capability.
+	CapabilityProjectIsLatest            Capability = "project is_latest"
+	CapabilityProjectCollection          Capability = "project collection"
+	CapabilityProjectCreateReturnsParent Capability = "project parent in create response"
+	CapabilityNotificationChildren       Capability = "notification rule notify_children"
+	CapabilityNotificationScheduled      Capability = "notification rule SCHEDULE trigger_type"
+	CapabilityAPIKeyPublicID             Capability = "team API key public ID"
+	CapabilityEventToken                 Capability = "event token processing status"
+	CapabilityProjectCollectionOmitted   Capability = "project collection logic omitted when not a collection"
+)
+
+type Capability string
+
+// Minimum DependencyTrack API version for each Capability.
+var capabilities = map[Capability]Semver{
+	CapabilityProjectIsLatest:            {Major: 4, Minor: 12, Patch: 0},
+	CapabilityProjectCollection:          {Major: 4, Minor: 13, Patch: 0},
+	CapabilityProjectCreateReturnsParent: {Major: 4, Minor: 12, Patch: 0},
+	CapabilityNotificationChildren:       {Major: 4, Minor: 12, Patch: 0},
+	CapabilityNotificationScheduled:      {Major: 4, Minor: 13, Patch: 0},
+	CapabilityAPIKeyPublicID:             {Major: 4, Minor: 13, Patch: 0},
+	CapabilityEventToken:                 {Major: 4, Minor: 11, Patch: 0},
+	CapabilityProjectCollectionOmitted:   {Major: 5, Minor: 0, Patch: 0},
+}
+
+// Supports returns whether the API version has the Capability.
+func (s Semver) Supports(capability Capability) bool {
+	required, ok := capabilities[capability]
+	return ok && s.Compare(required) >= 0
+}
+
+// Adds an error to the attribute, when the API version does not have the Capability. Used within ModifyPlan,
+// so that attributes which would otherwise be ignored by older API versions fail at plan time.
+func requireCapability(semver *Semver, capability Capability, attrPath path.Path, diagnostics *diag.Diagnostics) {
+	// Provider may not yet be configured, such as during validation.
+	if semver == nil || semver.Supports(capability) {
+		return
+	}
+	diagnostics.AddAttributeError(
+		attrPath,
+		"Unsupported DependencyTrack API version",
+		fmt.Sprintf("Attribute '%s' requires DependencyTrack API %s or later, for %s. Found API %s.",
+			attrPath, capabilities[capability], capability, semver),
+	)
+}
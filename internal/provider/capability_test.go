@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+func TestSemverSupports(t *testing.T) {
+	requireEqual(t, Semver{Major: 4, Minor: 11, Patch: 7}.Supports(CapabilityProjectIsLatest), false)
+	requireEqual(t, Semver{Major: 4, Minor: 12, Patch: 0}.Supports(CapabilityProjectIsLatest), true)
+	requireEqual(t, Semver{Major: 4, Minor: 12, Patch: 0}.Supports(CapabilityProjectCollection), false)
+	requireEqual(t, Semver{Major: 4, Minor: 13, Patch: 2}.Supports(CapabilityProjectCollection), true)
+	requireEqual(t, Semver{Major: 5, Minor: 0, Patch: 0}.Supports(CapabilityNotificationScheduled), true)
+	requireEqual(t, Semver{Major: 4, Minor: 13, Patch: 2}.Supports(CapabilityProjectCollectionOmitted), false)
+	requireEqual(t, Semver{Major: 5, Minor: 0, Patch: 0}.Supports(CapabilityProjectCollectionOmitted), true)
+	requireEqual(t, Semver{Major: 5, Minor: 0, Patch: 0}.Supports(Capability("unknown")), false)
+}
+
+func TestRequireCapability(t *testing.T) {
+	{
+		diags := diag.Diagnostics{}
+		requireCapability(nil, CapabilityProjectCollection, path.Root("collection"), &diags)
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		diags := diag.Diagnostics{}
+		requireCapability(&Semver{Major: 4, Minor: 13, Patch: 0}, CapabilityProjectCollection, path.Root("collection"), &diags)
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		diags := diag.Diagnostics{}
+		requireCapability(&Semver{Major: 4, Minor: 12, Patch: 5}, CapabilityProjectCollection, path.Root("collection"), &diags)
+		requireEqual(t, diags.ErrorsCount(), 1)
+		requireEqual(t, diags.Errors()[0].Detail(),
+			"Attribute 'collection' requires DependencyTrack API 4.13.0 or later, for project collection. Found API 4.12.5.")
+	}
+}
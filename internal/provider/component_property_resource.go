@@ -7,11 +7,13 @@ import (
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +22,7 @@ var (
 	_ resource.Resource                = &componentPropertyResource{}
 	_ resource.ResourceWithConfigure   = &componentPropertyResource{}
 	_ resource.ResourceWithImportState = &componentPropertyResource{}
+	_ resource.ResourceWithIdentity    = &componentPropertyResource{}
 )
 
 type (
@@ -87,11 +90,12 @@ func (*componentPropertyResource) Schema(_ context.Context, _ resource.SchemaReq
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the Component Property. See DependencyTrack for valid enum values.",
+				Description: "Type of the Component Property. Supports " + describeValues(propertyTypeValues) + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{stringvalidator.OneOf(propertyTypeValues...)},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the Component Property.",
@@ -105,6 +109,15 @@ func (*componentPropertyResource) Schema(_ context.Context, _ resource.SchemaReq
 	}
 }
 
+var componentPropertyIdentity = resourceIdentity{
+	{Name: "component_id", State: path.Root("component"), Description: "UUID of the Component."},
+	{Name: "id", State: path.Root("id"), Description: "UUID of the Component Property."},
+}
+
+func (*componentPropertyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = componentPropertyIdentity.Schema()
+}
+
 func (r *componentPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan componentPropertyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -159,6 +172,7 @@ func (r *componentPropertyResource) Create(ctx context.Context, req resource.Cre
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(componentPropertyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created a Component Property", map[string]any{
 		"id":          propertyState.ID.ValueString(),
 		"component":   propertyState.Component.ValueString(),
@@ -171,6 +185,7 @@ func (r *componentPropertyResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *componentPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(componentPropertyIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state componentPropertyResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -200,6 +215,9 @@ func (r *componentPropertyResource) Read(ctx context.Context, req resource.ReadR
 		"description": state.Description.ValueString(),
 	})
 	componentProperties, err := r.client.Component.GetProperties(ctx, componentID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": propertyID.String(), "component": componentID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, Unable to fetch Component Properties",
@@ -210,6 +228,9 @@ func (r *componentPropertyResource) Read(ctx context.Context, req resource.ReadR
 	componentProperty, err := Find(componentProperties, func(cp dtrack.ComponentProperty) bool {
 		return cp.UUID == propertyID
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": propertyID.String(), "component": componentID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, Unable to identify Component Property",
@@ -297,6 +318,7 @@ func (*componentPropertyResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(componentPropertyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Component Property", map[string]any{
 		"id":          state.ID.ValueString(),
 		"component":   state.Component.ValueString(),
@@ -357,11 +379,16 @@ func (r *componentPropertyResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *componentPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := componentPropertyIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected import id",
-			"Expected id in format <ComponentID>/<PropertyID>. Received "+req.ID,
+			"Expected id in format <ComponentID>/<PropertyID>. Received "+importID,
 		)
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -20,6 +22,7 @@ var (
 	_ resource.Resource                = &componentResource{}
 	_ resource.ResourceWithConfigure   = &componentResource{}
 	_ resource.ResourceWithImportState = &componentResource{}
+	_ resource.ResourceWithIdentity    = &componentResource{}
 )
 
 type (
@@ -117,10 +120,11 @@ func (*componentResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:    true,
 			},
 			"classifier": schema.StringAttribute{
-				Description: "Classifier of the Component. Defaults to LIBRARY. See DependencyTrack for valid options.",
+				Description: "Classifier of the Component. Defaults to \"LIBRARY\". Supports " + describeValues(classifierValues) + ".",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("LIBRARY"),
+				Validators:  []validator.String{stringvalidator.OneOf(classifierValues...)},
 			},
 			"filename": schema.StringAttribute{
 				Description: "Filename of the Component.",
@@ -232,6 +236,12 @@ func (*componentResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+var componentIdentity = uuidIdentity("UUID of the Component.")
+
+func (*componentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = componentIdentity.Schema()
+}
+
 func (r *componentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan componentResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -260,10 +270,12 @@ func (r *componentResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(componentIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Component", plan.debug())
 }
 
 func (r *componentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(componentIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state componentResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -282,6 +294,9 @@ func (r *componentResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 	tflog.Debug(ctx, "Reading Component", state.debug())
 	component, err := r.client.Component.Get(ctx, id)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to get Component",
@@ -329,6 +344,7 @@ func (r *componentResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(componentIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Component", plan.debug())
 }
 
@@ -362,7 +378,7 @@ func (*componentResource) ImportState(ctx context.Context, req resource.ImportSt
 	tflog.Debug(ctx, "Importing Component", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	componentIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -7,11 +7,13 @@ import (
 	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -19,6 +21,7 @@ import (
 var (
 	_ resource.Resource              = &configPropertiesResource{}
 	_ resource.ResourceWithConfigure = &configPropertiesResource{}
+	_ resource.ResourceWithMoveState = &configPropertiesResource{}
 )
 
 type (
@@ -50,7 +53,8 @@ func (*configPropertiesResource) Metadata(_ context.Context, req resource.Metada
 
 func (*configPropertiesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages multiple Config Properties.",
+		Description: "Manages multiple Config Properties. Supports `moved` from a `dependencytrack_config_property`. " +
+			"When migrating several, move one, and use `removed` with `destroy = false` for the others, so their values are not cleared.",
 		Attributes: map[string]schema.Attribute{
 			"properties": schema.ListNestedAttribute{
 				Description: "Config properties, to be bulk managed.",
@@ -76,11 +80,12 @@ func (*configPropertiesResource) Schema(_ context.Context, _ resource.SchemaRequ
 							Required:    true,
 						},
 						"type": schema.StringAttribute{
-							Description: "Type of the Config Property. See DependencyTrack for valid enum values.",
+							Description: "Type of the Config Property. Supports " + describeValues(propertyTypeValues) + ".",
 							Required:    true,
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.String{stringvalidator.OneOf(propertyTypeValues...)},
 						},
 						"description": schema.StringAttribute{
 							Description: "Description of the Config Property.",
@@ -96,6 +101,42 @@ func (*configPropertiesResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (*configPropertiesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// From a single `dependencytrack_config_property`. Others are added on the next apply.
+			SourceSchema: sourceSchema(ctx, NewConfigPropertyResource()),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, resp, "dependencytrack_config_property") {
+					return
+				}
+				var source configPropertyResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := configPropertiesResourceModel{
+					Properties: []configPropertiesElementResourceModel{{
+						Group:       source.Group,
+						Name:        source.Name,
+						Value:       source.Value,
+						Type:        source.Type,
+						Description: source.Description,
+					}},
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				tflog.Debug(ctx, "Moved Config Property into Config Properties", map[string]any{
+					"group": source.Group.ValueString(),
+					"name":  source.Name.ValueString(),
+				})
+			},
+		},
+	}
+}
+
 func (r *configPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan configPropertiesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccConfigPropertiesResource(t *testing.T) {
@@ -161,3 +163,90 @@ resource "dependencytrack_config_properties" "dt_config" {
 		},
 	})
 }
+
+func TestAccConfigPropertiesResourceMoveStateMultiple(t *testing.T) {
+	configMigrated := providerConfig + `
+resource "dependencytrack_config_properties" "test" {
+	properties = [
+		{
+			group = "email"
+			name = "smtp.enabled"
+			value = "true"
+			type = "BOOLEAN"
+		},
+		{
+			group = "email"
+			name = "subject.prefix"
+			value = "TF Test Move"
+			type = "STRING"
+		}
+	]
+}
+moved {
+	from = dependencytrack_config_property.smtp_enabled
+	to = dependencytrack_config_properties.test
+}
+removed {
+	from = dependencytrack_config_property.subject_prefix
+	lifecycle {
+		destroy = false
+	}
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_config_property" "smtp_enabled" {
+	group = "email"
+	name = "smtp.enabled"
+	value = "true"
+	type = "BOOLEAN"
+}
+resource "dependencytrack_config_property" "subject_prefix" {
+	group = "email"
+	name = "subject.prefix"
+	value = "TF Test Move"
+	type = "STRING"
+}
+`,
+			},
+			// Move one into the plural resource, and remove the other without destroying it.
+			// The removed property is only added to state, as its value is unchanged.
+			{
+				Config: configMigrated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_config_properties.test", plancheck.ResourceActionUpdate),
+					},
+					PostApplyPreRefresh:  nil,
+					PostApplyPostRefresh: nil,
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_config_properties.test", "properties.#", "2"),
+					resource.TestCheckResourceAttr("dependencytrack_config_properties.test", "properties.0.value", "true"),
+					resource.TestCheckResourceAttr("dependencytrack_config_properties.test", "properties.1.value", "TF Test Move"),
+				),
+			},
+			// Both values remain, so nothing is planned.
+			{
+				Config: configMigrated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+					PostApplyPreRefresh:  nil,
+					PostApplyPostRefresh: nil,
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_config_properties.test", "properties.#", "2"),
+					resource.TestCheckResourceAttr("dependencytrack_config_properties.test", "properties.1.value", "TF Test Move"),
+				),
+			},
+		},
+	})
+}
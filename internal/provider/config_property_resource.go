@@ -7,19 +7,24 @@ import (
 	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &configPropertyResource{}
-	_ resource.ResourceWithConfigure   = &configPropertyResource{}
-	_ resource.ResourceWithImportState = &configPropertyResource{}
+	_ resource.Resource                     = &configPropertyResource{}
+	_ resource.ResourceWithConfigure        = &configPropertyResource{}
+	_ resource.ResourceWithImportState      = &configPropertyResource{}
+	_ resource.ResourceWithIdentity         = &configPropertyResource{}
+	_ resource.ResourceWithConfigValidators = &configPropertyResource{}
 )
 
 type (
@@ -29,12 +34,14 @@ type (
 	}
 
 	configPropertyResourceModel struct {
-		ID          types.String `tfsdk:"id"`
-		Group       types.String `tfsdk:"group"`
-		Name        types.String `tfsdk:"name"`
-		Value       types.String `tfsdk:"value"`
-		Type        types.String `tfsdk:"type"`
-		Description types.String `tfsdk:"description"`
+		ID             types.String `tfsdk:"id"`
+		Group          types.String `tfsdk:"group"`
+		Name           types.String `tfsdk:"name"`
+		Value          types.String `tfsdk:"value"`
+		ValueWO        types.String `tfsdk:"value_wo"`
+		ValueWOVersion types.Int32  `tfsdk:"value_wo_version"`
+		Type           types.String `tfsdk:"type"`
+		Description    types.String `tfsdk:"description"`
 	}
 )
 
@@ -72,15 +79,31 @@ func (*configPropertyResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"value": schema.StringAttribute{
-				Description: "Value of the Config Property.",
-				Required:    true,
+				Description: "Value of the Config Property. Exactly one of `value` or `value_wo` must be set.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo"))},
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "Value of an `" + PropertyTypeEncryptedString + "` Config Property, which is never stored in state or plan. " +
+					"Sent on every create and update, as DependencyTrack replaces the value, so change `value_wo_version` to update to a new `value_wo`. " +
+					"Requires Terraform 1.11+.",
+				Sensitive:  true,
+				WriteOnly:  true,
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("value_wo_version"))},
+			},
+			"value_wo_version": schema.Int32Attribute{
+				Description: "Version of `value_wo`. Change to send the current value of `value_wo` to DependencyTrack.",
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.AlsoRequires(path.MatchRoot("value_wo"))},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the Config Property. See DependencyTrack for valid enum values.",
+				Description: "Type of the Config Property. Supports " + describeValues(propertyTypeValues) + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{stringvalidator.OneOf(propertyTypeValues...)},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the Config Property.",
@@ -90,6 +113,21 @@ func (*configPropertyResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (*configPropertyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		attributeRequiresValue(path.Root("value_wo"), path.Root("type"), PropertyTypeEncryptedString),
+	}
+}
+
+var configPropertyIdentity = resourceIdentity{
+	{Name: "group", State: path.Root("group"), Description: "Group name of the Config Property."},
+	{Name: "name", State: path.Root("name"), Description: "Name of the Config Property."},
+}
+
+func (*configPropertyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = configPropertyIdentity.Schema()
+}
+
 func (r *configPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan configPropertyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -98,17 +136,23 @@ func (r *configPropertyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	value := plan.Value.ValueString()
+	if !plan.ValueWOVersion.IsNull() {
+		value = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "value_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	propertyReq := dtrack.ConfigProperty{
 		GroupName: plan.Group.ValueString(),
 		Name:      plan.Name.ValueString(),
-		Value:     plan.Value.ValueString(),
+		Value:     value,
 		Type:      plan.Type.ValueString(),
 	}
 
 	tflog.Debug(ctx, "Configuring a config property", map[string]any{
 		"group": propertyReq.GroupName,
 		"name":  propertyReq.Name,
-		"value": propertyReq.Value,
 		"type":  propertyReq.Type,
 	})
 	propertyRes, err := r.client.Config.Update(ctx, propertyReq)
@@ -120,12 +164,14 @@ func (r *configPropertyResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 	propertyState := configPropertyResourceModel{
-		ID:          types.StringValue(fmt.Sprintf("%s/%s", propertyRes.GroupName, propertyRes.Name)),
-		Group:       types.StringValue(propertyRes.GroupName),
-		Name:        types.StringValue(propertyRes.Name),
-		Value:       types.StringValue(propertyRes.Value),
-		Type:        types.StringValue(propertyRes.Type),
-		Description: types.StringValue(propertyRes.Description),
+		ID:             types.StringValue(fmt.Sprintf("%s/%s", propertyRes.GroupName, propertyRes.Name)),
+		Group:          types.StringValue(propertyRes.GroupName),
+		Name:           types.StringValue(propertyRes.Name),
+		Value:          types.StringValue(propertyRes.Value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: plan.ValueWOVersion,
+		Type:           types.StringValue(propertyRes.Type),
+		Description:    types.StringValue(propertyRes.Description),
 	}
 	if propertyRes.Type == PropertyTypeEncryptedString {
 		propertyState.Value = plan.Value
@@ -143,6 +189,7 @@ func (r *configPropertyResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(configPropertyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created a config property", map[string]any{
 		"id":          propertyState.ID.ValueString(),
 		"group":       propertyState.Group.ValueString(),
@@ -154,6 +201,7 @@ func (r *configPropertyResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *configPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(configPropertyIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state configPropertyResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +217,9 @@ func (r *configPropertyResource) Read(ctx context.Context, req resource.ReadRequ
 		"name":  propertyName,
 	})
 	configProperty, err := r.client.Config.Get(ctx, groupName, propertyName)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"group": groupName, "name": propertyName}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("config"),
@@ -178,12 +229,14 @@ func (r *configPropertyResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 	propertyState := configPropertyResourceModel{
-		ID:          types.StringValue(fmt.Sprintf("%s/%s", configProperty.GroupName, configProperty.Name)),
-		Group:       types.StringValue(configProperty.GroupName),
-		Name:        types.StringValue(configProperty.Name),
-		Value:       types.StringValue(configProperty.Value),
-		Type:        types.StringValue(configProperty.Type),
-		Description: types.StringValue(configProperty.Description),
+		ID:             types.StringValue(fmt.Sprintf("%s/%s", configProperty.GroupName, configProperty.Name)),
+		Group:          types.StringValue(configProperty.GroupName),
+		Name:           types.StringValue(configProperty.Name),
+		Value:          types.StringValue(configProperty.Value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: state.ValueWOVersion,
+		Type:           types.StringValue(configProperty.Type),
+		Description:    types.StringValue(configProperty.Description),
 	}
 	if configProperty.Type == PropertyTypeEncryptedString {
 		propertyState.Value = state.Value
@@ -218,17 +271,23 @@ func (r *configPropertyResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	value := plan.Value.ValueString()
+	if !plan.ValueWOVersion.IsNull() {
+		value = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "value_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	propertyReq := dtrack.ConfigProperty{
 		GroupName: plan.Group.ValueString(),
 		Name:      plan.Name.ValueString(),
-		Value:     plan.Value.ValueString(),
+		Value:     value,
 		Type:      plan.Type.ValueString(),
 	}
 
 	tflog.Debug(ctx, "Updating config property", map[string]any{
 		"group": propertyReq.GroupName,
 		"name":  propertyReq.Name,
-		"value": propertyReq.Value,
 		"type":  propertyReq.Type,
 	})
 
@@ -241,12 +300,14 @@ func (r *configPropertyResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 	state := configPropertyResourceModel{
-		ID:          types.StringValue(fmt.Sprintf("%s/%s", propertyRes.GroupName, propertyRes.Name)),
-		Group:       types.StringValue(propertyRes.GroupName),
-		Name:        types.StringValue(propertyRes.Name),
-		Value:       types.StringValue(propertyRes.Value),
-		Type:        types.StringValue(propertyRes.Type),
-		Description: types.StringValue(propertyRes.Description),
+		ID:             types.StringValue(fmt.Sprintf("%s/%s", propertyRes.GroupName, propertyRes.Name)),
+		Group:          types.StringValue(propertyRes.GroupName),
+		Name:           types.StringValue(propertyRes.Name),
+		Value:          types.StringValue(propertyRes.Value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: plan.ValueWOVersion,
+		Type:           types.StringValue(propertyRes.Type),
+		Description:    types.StringValue(propertyRes.Description),
 	}
 	if propertyRes.Type == PropertyTypeEncryptedString {
 		state.Value = plan.Value
@@ -264,6 +325,7 @@ func (r *configPropertyResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(configPropertyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated config property", map[string]any{
 		"id":          state.ID.ValueString(),
 		"group":       state.Group.ValueString(),
@@ -311,11 +373,16 @@ func (r *configPropertyResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *configPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := configPropertyIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected import id",
-			"Expected id in format <Group>/<Name>. Received "+req.ID,
+			"Expected id in format <Group>/<Name>. Received "+importID,
 		)
 		return
 	}
@@ -339,9 +406,11 @@ func (r *configPropertyResource) ImportState(ctx context.Context, req resource.I
 		Group: types.StringValue(property.GroupName),
 		Name:  types.StringValue(property.Name),
 		// If Type == "ENCRYPTEDSTRING", then Value will be placeholder text.
-		Value:       types.StringValue(property.Value),
-		Type:        types.StringValue(property.Type),
-		Description: types.StringValue(property.Description),
+		Value:          types.StringValue(property.Value),
+		ValueWO:        types.StringNull(),
+		ValueWOVersion: types.Int32Null(),
+		Type:           types.StringValue(property.Type),
+		Description:    types.StringValue(property.Description),
 	}
 	diags := resp.State.Set(ctx, propertyState)
 	resp.Diagnostics.Append(diags...)
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccConfigPropertyResource(t *testing.T) {
@@ -161,3 +163,59 @@ resource "dependencytrack_config_property" "dt_config" {
 		},
 	})
 }
+
+func TestAccConfigPropertyResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_config_property" "test" {
+	group = "email"
+	name = "smtp.password"
+	value_wo = "TEST_PASSWORD"
+	value_wo_version = 1
+	type = "ENCRYPTEDSTRING"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_config_property.test", "id", "email/smtp.password"),
+					resource.TestCheckNoResourceAttr("dependencytrack_config_property.test", "value"),
+					resource.TestCheckNoResourceAttr("dependencytrack_config_property.test", "value_wo"),
+					resource.TestCheckResourceAttr("dependencytrack_config_property.test", "value_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_config_property" "test" {
+	group = "email"
+	name = "smtp.password"
+	value_wo = "TEST_PASSWORD_WITH_CHANGE"
+	value_wo_version = 2
+	type = "ENCRYPTEDSTRING"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dependencytrack_config_property.test", "value"),
+					resource.TestCheckResourceAttr("dependencytrack_config_property.test", "value_wo_version", "2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_config_property" "test" {
+	group = "email"
+	name = "subject.prefix"
+	value_wo = "TF Test"
+	value_wo_version = 1
+	type = "STRING"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute 'value_wo' requires 'type' to be "ENCRYPTEDSTRING"`),
+			},
+		},
+	})
+}
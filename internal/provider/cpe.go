@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+const (
+	// CPE logical value, matching any value.
+	CPEAny = "*"
+	// CPE logical value, for not applicable.
+	CPENotApplicable = "-"
+)
+
+// Names of CPE attributes, in order of the CPE 2.3 formatted string binding, NISTIR 7695.
+var cpeAttributeNames = []string{
+	"part", "vendor", "product", "version", "update", "edition",
+	"language", "sw_edition", "target_sw", "target_hw", "other",
+}
+
+// Parses a CPE, either a 2.3 formatted string, 'cpe:2.3:<part>:...', or a 2.2 URI, 'cpe:/<part>:...'.
+// Returns the unescaped value of each attribute, keyed by name, with missing attributes as CPEAny.
+func parseCPE(cpe string) (map[string]string, error) {
+	cpe = strings.TrimSpace(cpe)
+	var values []string
+	switch {
+	case strings.HasPrefix(strings.ToLower(cpe), "cpe:2.3:"):
+		values = splitCPEFormattedString(cpe[len("cpe:2.3:"):])
+		if len(values) != len(cpeAttributeNames) {
+			return nil, fmt.Errorf("expected %d attributes after 'cpe:2.3:', received %d", len(cpeAttributeNames), len(values))
+		}
+		values = Map(values, unescapeCPE)
+	case strings.HasPrefix(strings.ToLower(cpe), "cpe:/"):
+		var err error
+		values, err = splitCPEURI(cpe[len("cpe:/"):])
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errors.New("expected prefix 'cpe:2.3:' or 'cpe:/'")
+	}
+
+	attributes := make(map[string]string, len(cpeAttributeNames))
+	for index, name := range cpeAttributeNames {
+		attributes[name] = CPEAny
+		if index < len(values) && values[index] != "" {
+			attributes[name] = values[index]
+		}
+	}
+	if err := validateCPEPart(attributes["part"]); err != nil {
+		return nil, err
+	}
+	return attributes, nil
+}
+
+// Formats CPE attributes as a 2.3 formatted string, escaping special characters. Missing or empty attributes are CPEAny.
+func formatCPE(attributes map[string]string) (string, error) {
+	if err := validateCPEPart(attributes["part"]); err != nil {
+		return "", err
+	}
+	values := make([]string, 0, len(cpeAttributeNames))
+	for _, name := range cpeAttributeNames {
+		values = append(values, escapeCPE(attributes[name]))
+	}
+	return "cpe:2.3:" + strings.Join(values, ":"), nil
+}
+
+func validateCPEPart(part string) error {
+	switch part {
+	case "a", "o", "h", CPEAny, CPENotApplicable:
+		return nil
+	default:
+		return fmt.Errorf("invalid part '%s', expected 'a' for application, 'o' for operating system, or 'h' for hardware", part)
+	}
+}
+
+// Splits on ':', other than those escaped by '\'.
+func splitCPEFormattedString(value string) []string {
+	parts := []string{}
+	var current strings.Builder
+	escaped := false
+	for _, char := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case char == '\\':
+			escaped = true
+		case char == ':':
+			parts = append(parts, current.String())
+			current.Reset()
+			continue
+		}
+		current.WriteRune(char)
+	}
+	return append(parts, current.String())
+}
+
+// Splits a 2.2 URI into attribute values, unpacking the packed edition of 2.3 extended attributes.
+func splitCPEURI(value string) ([]string, error) {
+	values := strings.Split(value, ":")
+	if len(values) > 7 {
+		return nil, fmt.Errorf("expected at most 7 attributes after 'cpe:/', received %d", len(values))
+	}
+	for index, encoded := range values {
+		decoded, err := url.PathUnescape(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid attribute '%s': %w", encoded, err)
+		}
+		values[index] = decoded
+	}
+	values = append(values, make([]string, 7-len(values))...)
+	edition, language := values[5], values[6]
+	result := slices.Clone(values[:5])
+	if strings.HasPrefix(edition, "~") {
+		packed := strings.Split(edition, "~")
+		if len(packed) != 6 {
+			return nil, fmt.Errorf("invalid packed edition '%s', expected '~<edition>~<sw_edition>~<target_sw>~<target_hw>~<other>'", edition)
+		}
+		return append(result, packed[1], language, packed[2], packed[3], packed[4], packed[5]), nil
+	}
+	return append(result, edition, language, "", "", "", ""), nil
+}
+
+func unescapeCPE(value string) string {
+	var builder strings.Builder
+	escaped := false
+	for _, char := range value {
+		if !escaped && char == '\\' {
+			escaped = true
+			continue
+		}
+		escaped = false
+		builder.WriteRune(char)
+	}
+	return builder.String()
+}
+
+// Escapes all characters other than letters, digits, '_', '-' and '.', unless a logical value.
+func escapeCPE(value string) string {
+	if value == "" {
+		return CPEAny
+	}
+	if value == CPEAny || value == CPENotApplicable {
+		return value
+	}
+	var builder strings.Builder
+	for _, char := range value {
+		switch {
+		case 'a' <= char && char <= 'z', 'A' <= char && char <= 'Z', '0' <= char && char <= '9',
+			char == '_', char == '-', char == '.':
+		default:
+			builder.WriteRune('\\')
+		}
+		builder.WriteRune(char)
+	}
+	return builder.String()
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseCPE(t *testing.T) {
+	{
+		cpe, err := parseCPE(`cpe:2.3:a:apache:log4j:2.17.0:*:*:*:*:*:*:*`)
+		requireNoError(t, err)
+		requireEqual(t, cpe["part"], "a")
+		requireEqual(t, cpe["vendor"], "apache")
+		requireEqual(t, cpe["product"], "log4j")
+		requireEqual(t, cpe["version"], "2.17.0")
+		requireEqual(t, cpe["other"], CPEAny)
+	}
+	{
+		cpe, err := parseCPE(`cpe:2.3:a:microsoft:internet_explorer:8.0.6001:beta:*:*:*:*:*:*`)
+		requireNoError(t, err)
+		requireEqual(t, cpe["update"], "beta")
+	}
+	{
+		cpe, err := parseCPE(`cpe:2.3:a:hp:insight_diagnostics:7.4.0.1570:-:*:*:online:win2003:x64:*`)
+		requireNoError(t, err)
+		requireEqual(t, cpe["update"], CPENotApplicable)
+		requireEqual(t, cpe["sw_edition"], "online")
+		requireEqual(t, cpe["target_hw"], "x64")
+	}
+	{
+		cpe, err := parseCPE(`cpe:2.3:a:example:foo\:bar:1.0\!:*:*:*:*:*:*:*`)
+		requireNoError(t, err)
+		requireEqual(t, cpe["product"], "foo:bar")
+		requireEqual(t, cpe["version"], "1.0!")
+	}
+	{
+		cpe, err := parseCPE(`cpe:/a:hp:insight_diagnostics:7.4.0.1570::~~online~win2003~x64~:en`)
+		requireNoError(t, err)
+		requireEqual(t, cpe["update"], CPEAny)
+		requireEqual(t, cpe["language"], "en")
+		requireEqual(t, cpe["sw_edition"], "online")
+		requireEqual(t, cpe["target_sw"], "win2003")
+		requireEqual(t, cpe["target_hw"], "x64")
+	}
+	{
+		cpe, err := parseCPE(`cpe:/o:linux:linux_kernel`)
+		requireNoError(t, err)
+		requireEqual(t, cpe["part"], "o")
+		requireEqual(t, cpe["version"], CPEAny)
+	}
+	{
+		_, err := parseCPE(`cpe:2.3:a:apache:log4j`)
+		requireError(t, err, "^expected 11 attributes after 'cpe:2.3:', received 3$")
+	}
+	{
+		_, err := parseCPE(`cpe:2.3:x:apache:log4j:2.17.0:*:*:*:*:*:*:*`)
+		requireError(t, err, "^invalid part 'x'")
+	}
+	{
+		_, err := parseCPE(`pkg:maven/org.example/artifact`)
+		requireError(t, err, "^expected prefix 'cpe:2.3:' or 'cpe:/'$")
+	}
+}
+
+func TestFormatCPE(t *testing.T) {
+	{
+		cpe, err := formatCPE(map[string]string{"part": "a", "vendor": "apache", "product": "log4j", "version": "2.17.0"})
+		requireNoError(t, err)
+		requireEqual(t, cpe, `cpe:2.3:a:apache:log4j:2.17.0:*:*:*:*:*:*:*`)
+	}
+	{
+		cpe, err := formatCPE(map[string]string{"part": "a", "vendor": "example", "product": "foo:bar", "version": "1.0!", "update": CPENotApplicable})
+		requireNoError(t, err)
+		requireEqual(t, cpe, `cpe:2.3:a:example:foo\:bar:1.0\!:-:*:*:*:*:*:*`)
+		parsed, err := parseCPE(cpe)
+		requireNoError(t, err)
+		requireEqual(t, parsed["product"], "foo:bar")
+	}
+	{
+		_, err := formatCPE(map[string]string{"vendor": "apache"})
+		requireError(t, err, "^invalid part ''")
+	}
+}
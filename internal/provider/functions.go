@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Reads a dynamic function argument, which must be an object or a map, into its attribute values.
+// Attributes not in names are rejected, to surface typos at plan time.
+func readFunctionObject(ctx context.Context, argument types.Dynamic, names []string) (map[string]tftypes.Value, error) {
+	if argument.IsNull() || argument.IsUnderlyingValueNull() {
+		return nil, errors.New("expected an object, received null")
+	}
+	value, err := argument.UnderlyingValue().ToTerraformValue(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attributes := map[string]tftypes.Value{}
+	if err = value.As(&attributes); err != nil {
+		return nil, fmt.Errorf("expected an object or a map, received %s", value.Type().String())
+	}
+	for name := range attributes {
+		if !slices.Contains(names, name) {
+			return nil, fmt.Errorf("unexpected attribute '%s', expected one of '%s'", name, strings.Join(names, "', '"))
+		}
+	}
+	return attributes, nil
+}
+
+// Reads a string attribute of a function argument, with missing or null as empty.
+func readFunctionString(attributes map[string]tftypes.Value, name string) (string, error) {
+	value, ok := attributes[name]
+	if !ok || value.IsNull() {
+		return "", nil
+	}
+	if !value.Type().Is(tftypes.String) {
+		return "", fmt.Errorf("expected attribute '%s' to be a string, received %s", name, value.Type().String())
+	}
+	var result string
+	if err := value.As(&result); err != nil {
+		return "", fmt.Errorf("unable to read attribute '%s': %w", name, err)
+	}
+	return result, nil
+}
+
+// Reads an object or map of strings attribute of a function argument, with missing or null as empty.
+func readFunctionStringMap(attributes map[string]tftypes.Value, name string) (map[string]string, error) {
+	value, ok := attributes[name]
+	if !ok || value.IsNull() {
+		return map[string]string{}, nil
+	}
+	entries := map[string]tftypes.Value{}
+	if err := value.As(&entries); err != nil {
+		return nil, fmt.Errorf("expected attribute '%s' to be an object or a map, received %s", name, value.Type().String())
+	}
+	result := make(map[string]string, len(entries))
+	for key := range entries {
+		entry, err := readFunctionString(entries, key)
+		if err != nil {
+			return nil, fmt.Errorf("within attribute '%s', %w", name, err)
+		}
+		result[key] = entry
+	}
+	return result, nil
+}
+
+// Converts an empty string to null, for absent optional components in function results.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBuildPURLFunctionRun(t *testing.T) {
+	ctx := t.Context()
+	qualifiers := types.MapValueMust(types.StringType, map[string]attr.Value{"b": types.StringValue("2"), "a": types.StringValue("1")})
+	components := types.ObjectValueMust(
+		map[string]attr.Type{"type": types.StringType, "name": types.StringType, "version": types.StringType, "qualifiers": qualifiers.Type(ctx)},
+		map[string]attr.Value{"type": types.StringValue("PyPI"), "name": types.StringValue("Django_Rest"), "version": types.StringNull(), "qualifiers": qualifiers},
+	)
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(components)})}
+	resp := function.RunResponse{Error: nil, Result: function.NewResultData(types.StringUnknown())}
+	NewBuildPURLFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("Expected no error, received %v", resp.Error)
+	}
+	result, ok := resp.Result.Value().(types.String)
+	requireEqual(t, ok, true)
+	requireEqual(t, result.ValueString(), "pkg:pypi/django-rest?a=1&b=2")
+
+	version := types.ObjectValueMust(
+		map[string]attr.Type{"type": types.StringType, "name": types.StringType, "version": types.NumberType},
+		map[string]attr.Value{"type": types.StringValue("npm"), "name": types.StringValue("core"), "version": types.NumberNull()},
+	)
+	req = function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(version)})}
+	NewBuildPURLFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("Expected null attribute to be ignored, received %v", resp.Error)
+	}
+}
+
+func TestBuildCPEFunctionRun(t *testing.T) {
+	ctx := t.Context()
+	attributes := types.MapValueMust(types.StringType, map[string]attr.Value{"part": types.StringValue("a"), "vendor": types.StringValue("apache"), "product": types.StringValue("log4j")})
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(attributes)})}
+	resp := function.RunResponse{Error: nil, Result: function.NewResultData(types.StringUnknown())}
+	NewBuildCPEFunction().Run(ctx, req, &resp)
+	if resp.Error != nil {
+		t.Fatalf("Expected no error, received %v", resp.Error)
+	}
+	result, ok := resp.Result.Value().(types.String)
+	requireEqual(t, ok, true)
+	requireEqual(t, result.ValueString(), "cpe:2.3:a:apache:log4j:*:*:*:*:*:*:*:*")
+
+	invalid := types.ObjectValueMust(map[string]attr.Type{"part": types.BoolType}, map[string]attr.Value{"part": types.BoolValue(true)})
+	req = function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{types.DynamicValue(invalid)})}
+	NewBuildCPEFunction().Run(ctx, req, &resp)
+	if resp.Error == nil || resp.Error.Text != "Invalid CPE attributes: expected attribute 'part' to be a string, received tftypes.Bool" {
+		t.Fatalf("Expected error for non-string attribute, received %v", resp.Error)
+	}
+}
@@ -1,13 +1,36 @@
 package provider
 
 import (
+	"bytes"
+	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/rand/v2"
 	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
 
-	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryMinWait     = 1 * time.Second
+	DefaultRetryMaxWait     = 30 * time.Second
+	// Tokens are refreshed this long before their expiry, to allow for clock skew and request latency.
+	TokenExpiryMargin = 30 * time.Second
 )
 
 type (
@@ -16,9 +39,78 @@ type (
 		Value string
 	}
 
+	HTTPClientOptions struct {
+		ProxyURL          *url.URL
+		ClientCertificate *tls.Certificate
+		Headers           []Header
+		RootCAs           []byte
+		TLS               TLSOptions
+		Retry             RetryOptions
+		Limit             LimitOptions
+		Timeout           time.Duration
+		// LogRequests enables logging of each request within HTTPLogSubsystem.
+		LogRequests bool
+	}
+
+	TLSOptions struct {
+		ServerName         string
+		MinVersion         uint16
+		InsecureSkipVerify bool
+	}
+
+	RetryOptions struct {
+		MaxAttempts int
+		MinWait     time.Duration
+		MaxWait     time.Duration
+	}
+
 	transport struct {
 		inner   http.RoundTripper
 		headers []Header
+		logging bool
+	}
+
+	retryTransport struct {
+		inner   http.RoundTripper
+		options RetryOptions
+	}
+
+	timeoutTransport struct {
+		inner   http.RoundTripper
+		timeout time.Duration
+	}
+
+	LimitOptions struct {
+		MaxConcurrentRequests int
+		RequestsPerSecond     int
+	}
+
+	limitTransport struct {
+		next     time.Time
+		inner    http.RoundTripper
+		slots    chan struct{}
+		interval time.Duration
+		mutex    sync.Mutex
+	}
+
+	TokenFetchFunc func(ctx context.Context) (string, time.Time, error)
+
+	tokenTransport struct {
+		expiry time.Time
+		inner  http.RoundTripper
+		fetch  TokenFetchFunc
+		token  string
+		mutex  sync.Mutex
+	}
+
+	cancelOnCloseBody struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+
+	releaseOnCloseBody struct {
+		io.ReadCloser
+		release func()
 	}
 )
 
@@ -26,42 +118,330 @@ func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	for _, header := range t.headers {
 		req.Header.Add(header.Name, header.Value)
 	}
+	if t.logging {
+		return t.logRoundTrip(req)
+	}
 	return t.inner.RoundTrip(req)
 }
 
-func NewHTTPClient(headers []Header, pemCerts []byte, clientCertFile string, clientKeyFile string) (*http.Client, error) {
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.options.MaxAttempts <= 1 {
+		return t.inner.RoundTrip(req)
+	}
+	ctx := req.Context()
+	idempotent := isIdempotentRequest(req)
+	replayReq, bufferErr := withReplayableBody(req)
+	if bufferErr != nil {
+		return nil, bufferErr
+	}
+	for attempt := 1; ; attempt++ {
+		attemptReq, rewindErr := rewindRequest(replayReq)
+		if rewindErr != nil {
+			return nil, rewindErr
+		}
+		// Records whether the request was written, after which DependencyTrack may have processed it.
+		var written atomic.Bool
+		attemptReq = attemptReq.WithContext(httptrace.WithClientTrace(attemptReq.Context(), &httptrace.ClientTrace{
+			WroteHeaders: func() { written.Store(true) },
+		}))
+		res, err := t.inner.RoundTrip(attemptReq)
+		if attempt >= t.options.MaxAttempts || !shouldRetry(ctx, res, err, idempotent, written.Load()) {
+			return res, err
+		}
+		wait := t.backoff(attempt, res)
+		fields := map[string]any{
+			"method":       req.Method,
+			"path":         req.URL.Path,
+			"attempt":      attempt,
+			"max_attempts": t.options.MaxAttempts,
+			"wait":         wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		}
+		if res != nil {
+			fields["status"] = res.StatusCode
+			// Drain body, to allow connection to be reused.
+			_, _ = io.Copy(io.Discard, res.Body)
+			_ = res.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying DependencyTrack API request", fields)
+		if sleepErr := sleepContext(ctx, wait); sleepErr != nil {
+			return nil, sleepErr
+		}
+	}
+}
+
+// Calculates the delay before the next attempt, preferring a `Retry-After` header when the server sent one.
+func (t *retryTransport) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(retryAfter, t.options.MaxWait)
+		}
+	}
+	wait := t.options.MinWait
+	for i := 1; i < attempt && wait < t.options.MaxWait; i++ {
+		wait *= 2
+	}
+	wait = min(wait, t.options.MaxWait)
+	if wait <= 0 {
+		return 0
+	}
+	// Equal jitter, to avoid multiple resources retrying in lockstep.
+	half := wait / 2
+	return half + time.Duration(rand.Int64N(int64(wait-half)+1)) //nolint:gosec // Jitter does not require a cryptographically secure source.
+}
+
+func (t *timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.inner.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	res, err := t.inner.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// Response body is read after RoundTrip returns, so only cancel once it has been closed.
+	res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}
+	return res, nil
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
+func newLimitTransport(inner http.RoundTripper, options LimitOptions) http.RoundTripper {
+	if options.MaxConcurrentRequests <= 0 && options.RequestsPerSecond <= 0 {
+		return inner
+	}
+	limiter := &limitTransport{
+		next:     time.Time{},
+		inner:    inner,
+		slots:    nil,
+		interval: 0,
+		mutex:    sync.Mutex{},
+	}
+	if options.MaxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, options.MaxConcurrentRequests)
+	}
+	if options.RequestsPerSecond > 0 {
+		limiter.interval = time.Second / time.Duration(options.RequestsPerSecond)
+	}
+	return limiter
+}
+
+func (t *limitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	// Slot is held until the response body is closed, as the server is still processing the request until then.
+	release := sync.OnceFunc(func() {
+		if t.slots != nil {
+			<-t.slots
+		}
+	})
+	if sleepErr := sleepContext(ctx, t.reserve()); sleepErr != nil {
+		release()
+		return nil, sleepErr
+	}
+	tflog.Debug(ctx, "Queued DependencyTrack API request", map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+		"queued": time.Since(start).String(),
+	})
+	res, err := t.inner.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	res.Body = &releaseOnCloseBody{ReadCloser: res.Body, release: release}
+	return res, nil
+}
+
+// Reserves the next request slot within the rate limit, returning the time to wait until it.
+func (t *limitTransport) reserve() time.Duration {
+	if t.interval <= 0 {
+		return 0
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	wait := t.next.Sub(now)
+	t.next = t.next.Add(t.interval)
+	return wait
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+func newTokenTransport(inner http.RoundTripper, fetch TokenFetchFunc) *tokenTransport {
+	return &tokenTransport{
+		expiry: time.Time{},
+		inner:  inner,
+		fetch:  fetch,
+		token:  "",
+		mutex:  sync.Mutex{},
+	}
+}
+
+func (t *tokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	token, err := t.currentToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+	replayReq, err := withReplayableBody(req)
+	if err != nil {
+		return nil, err
+	}
+	res, err := t.roundTripWithToken(replayReq, token)
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+	// Token may have expired early, or been revoked, so fetch a new token and attempt once more.
+	// Request was rejected before being processed, so is safe to replay regardless of method.
+	_, _ = io.Copy(io.Discard, res.Body)
+	_ = res.Body.Close()
+	tflog.Debug(ctx, "Received 401 from DependencyTrack API, refreshing token", map[string]any{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	})
+	token, err = t.refresh(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	return t.roundTripWithToken(replayReq, token)
+}
+
+func (t *tokenTransport) roundTripWithToken(req *http.Request, token string) (*http.Response, error) {
+	attemptReq, err := rewindRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	attemptReq.Header.Set("Authorization", "Bearer "+token)
+	return t.inner.RoundTrip(attemptReq)
+}
+
+func (t *tokenTransport) currentToken(ctx context.Context) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.token != "" && (t.expiry.IsZero() || time.Now().Add(TokenExpiryMargin).Before(t.expiry)) {
+		return t.token, nil
+	}
+	return t.fetchLocked(ctx)
+}
+
+// Fetches a new token, unless another request has already replaced the stale token.
+func (t *tokenTransport) refresh(ctx context.Context, stale string) (string, error) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.token != stale {
+		return t.token, nil
+	}
+	return t.fetchLocked(ctx)
+}
+
+func (t *tokenTransport) fetchLocked(ctx context.Context) (string, error) {
+	tflog.Debug(ctx, "Fetching DependencyTrack authentication token", map[string]any{
+		"previous_expiry": t.expiry.String(),
+	})
+	token, expiry, err := t.fetch(ctx)
+	if err != nil {
+		return "", errors.New("unable to fetch authentication token, from: " + err.Error())
+	}
+	t.token = token
+	t.expiry = expiry
+	tflog.Debug(ctx, "Fetched DependencyTrack authentication token", map[string]any{
+		"expiry": expiry.String(),
+	})
+	return token, nil
+}
+
+func NewHTTPClient(options HTTPClientOptions) (*http.Client, error) {
 	// Create x509.CertPool for RootCA.
-	rootCAs, err := newCertPool(pemCerts)
+	rootCAs, err := newCertPool(options.RootCAs)
 	if err != nil {
 		return nil, err
 	}
-	// Create and configure underlying transport for TLS.
-	innerTransport, ok := http.DefaultTransport.(*http.Transport)
+	// Clone underlying transport, so that multiple provider instances do not share TLS configuration.
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
 	if !ok {
 		return nil, fmt.Errorf("expected http.DefaultTransport to be a *http.Transport. Found %T", http.DefaultTransport)
 	}
+	innerTransport := defaultTransport.Clone()
 	innerTransport.TLSClientConfig = &tls.Config{
-		RootCAs:    rootCAs,
-		MinVersion: tls.VersionTLS13,
+		RootCAs:            rootCAs,
+		MinVersion:         options.TLS.MinVersion,
+		ServerName:         options.TLS.ServerName,
+		InsecureSkipVerify: options.TLS.InsecureSkipVerify, //nolint:gosec // Explicitly opted into by provider configuration, with a warning.
+	}
+	if options.ProxyURL != nil {
+		innerTransport.Proxy = http.ProxyURL(options.ProxyURL)
 	}
 	// Configure mTLS.
-	if clientCertFile != "" && clientKeyFile != "" {
-		innerTransport.TLSClientConfig.MinVersion = tls.VersionTLS13
-		keypair, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
-		if err != nil {
-			return nil, err
-		}
-		innerTransport.TLSClientConfig.Certificates = []tls.Certificate{keypair}
+	if options.ClientCertificate != nil {
+		innerTransport.TLSClientConfig.Certificates = []tls.Certificate{*options.ClientCertificate}
 	}
 	return &http.Client{
-		Timeout: dtrack.DefaultTimeout,
-		Transport: &transport{
-			inner:   innerTransport,
-			headers: headers,
+		// Timeout is applied to each attempt within retryTransport, rather than across all attempts.
+		Timeout: 0,
+		Transport: &retryTransport{
+			// Limits are applied to each attempt, but not while waiting between attempts.
+			inner: newLimitTransport(&timeoutTransport{
+				inner: &transport{
+					inner:   innerTransport,
+					headers: options.Headers,
+					logging: options.LogRequests,
+				},
+				timeout: options.Timeout,
+			}, options.Limit),
+			options: options.Retry,
 		},
 	}, nil
 }
 
+// Creates a HTTP Client for a third party, such as an OIDC identity provider, from a HTTP Client created by NewHTTPClient.
+// Only the TLS, proxy and timeout configuration is retained, so that headers, authentication, logging and retries for DependencyTrack are not applied.
+func newThirdPartyHTTPClient(httpClient *http.Client) (*http.Client, error) {
+	client := &http.Client{Timeout: httpClient.Timeout}
+	next := httpClient.Transport
+	for {
+		switch current := next.(type) {
+		case *http.Transport:
+			client.Transport = current.Clone()
+			return client, nil
+		case *retryTransport:
+			next = current.inner
+		case *limitTransport:
+			next = current.inner
+		case *timeoutTransport:
+			client.Timeout = current.timeout
+			next = current.inner
+		case *transport:
+			next = current.inner
+		case *tokenTransport:
+			next = current.inner
+		default:
+			return nil, fmt.Errorf("unable to locate underlying *http.Transport. Found %T", next)
+		}
+	}
+}
+
 func newCertPool(pemCerts []byte) (*x509.CertPool, error) {
 	if len(pemCerts) == 0 {
 		return x509.SystemCertPool()
@@ -72,3 +452,182 @@ func newCertPool(pemCerts []byte) (*x509.CertPool, error) {
 	}
 	return certPool, nil
 }
+
+// Parses a PKCS#12 bundle containing a private key, client certificate, and optionally intermediate certificates.
+func parsePKCS12(data []byte, password string) (tls.Certificate, error) {
+	privateKey, leaf, chain, err := pkcs12.DecodeChain(data, password)
+	if err != nil {
+		return tls.Certificate{}, errors.New("unable to decode PKCS#12 bundle, from: " + err.Error())
+	}
+	certificates := [][]byte{leaf.Raw}
+	for _, cert := range chain {
+		certificates = append(certificates, cert.Raw)
+	}
+	return tls.Certificate{
+		Certificate: certificates,
+		PrivateKey:  privateKey,
+		Leaf:        leaf,
+	}, nil
+}
+
+// Whether a request may be repeated once DependencyTrack may have processed it.
+// PUT is excluded, as DependencyTrack uses it to create Projects, Vulnerabilities and Analyses.
+func isIdempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// Buffers the request body in memory when it cannot otherwise be replayed, so that each attempt sends the same body.
+func withReplayableBody(req *http.Request) (*http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody || req.GetBody != nil {
+		return req, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	_ = req.Body.Close()
+	if err != nil {
+		return nil, errors.New("unable to buffer request body for retries, from: " + err.Error())
+	}
+	buffered := req.Clone(req.Context())
+	buffered.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return buffered, nil
+}
+
+func rewindRequest(req *http.Request) (*http.Request, error) {
+	attemptReq := req.Clone(req.Context())
+	if req.GetBody == nil {
+		return attemptReq, nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, errors.New("unable to rewind request body for retry, from: " + err.Error())
+	}
+	attemptReq.Body = body
+	return attemptReq, nil
+}
+
+// Any request is retried when it failed before being written, such as when unable to connect.
+// Otherwise, only idempotent requests are retried, as DependencyTrack may have processed the request.
+func shouldRetry(ctx context.Context, res *http.Response, err error, idempotent bool, written bool) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		connectionErr := errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) ||
+			errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+		return connectionErr && (idempotent || !written)
+	}
+	if !idempotent {
+		return false
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// Parses `Retry-After` in either delay-seconds or HTTP-date form.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	return max(time.Until(date), 0), true
+}
+
+// Fetches an access token using the OAuth2 client credentials grant, RFC 6749 Section 4.4.
+func newClientCredentialsTokenFetch(httpClient *http.Client, tokenURL string, clientID string, clientSecret string, scopes []string) TokenFetchFunc {
+	return func(ctx context.Context) (string, time.Time, error) {
+		form := url.Values{}
+		form.Set("grant_type", "client_credentials")
+		if len(scopes) > 0 {
+			form.Set("scope", strings.Join(scopes, " "))
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.Header.Set("Accept", "application/json")
+		req.SetBasicAuth(url.QueryEscape(clientID), url.QueryEscape(clientSecret))
+		res, err := httpClient.Do(req)
+		if err != nil {
+			return "", time.Time{}, errors.New("unable to request token, from: " + err.Error())
+		}
+		defer func() { _ = res.Body.Close() }()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			return "", time.Time{}, errors.New("unable to read token response, from: " + err.Error())
+		}
+		if res.StatusCode != http.StatusOK {
+			return "", time.Time{}, fmt.Errorf("token endpoint responded with status %d: %s", res.StatusCode, string(body))
+		}
+		tokenRes := struct {
+			AccessToken string `json:"access_token"`
+			TokenType   string `json:"token_type"`
+			ExpiresIn   int64  `json:"expires_in"`
+		}{}
+		err = json.Unmarshal(body, &tokenRes)
+		if err != nil {
+			return "", time.Time{}, errors.New("unable to parse token response, from: " + err.Error())
+		}
+		if tokenRes.AccessToken == "" {
+			return "", time.Time{}, errors.New("token response did not contain an access_token")
+		}
+		if tokenRes.TokenType != "" && !strings.EqualFold(tokenRes.TokenType, "Bearer") {
+			return "", time.Time{}, fmt.Errorf("expected token_type of Bearer, found %s", tokenRes.TokenType)
+		}
+		expiry := jwtExpiry(tokenRes.AccessToken)
+		if tokenRes.ExpiresIn > 0 {
+			expiry = time.Now().Add(time.Duration(tokenRes.ExpiresIn) * time.Second)
+		}
+		return tokenRes.AccessToken, expiry, nil
+	}
+}
+
+// Reads the `exp` claim from a JWT, without verifying the signature. Returns the zero time if unavailable.
+func jwtExpiry(token string) time.Time {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Time{}
+	}
+	claims := struct {
+		Expiry int64 `json:"exp"`
+	}{}
+	err = json.Unmarshal(payload, &claims)
+	if err != nil || claims.Expiry == 0 {
+		return time.Time{}
+	}
+	return time.Unix(claims.Expiry, 0)
+}
+
+func sleepContext(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
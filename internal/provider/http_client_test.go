@@ -0,0 +1,431 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Counts attempts made by retryTransport, including those which fail before a response.
+type attemptCountingTransport struct {
+	inner    http.RoundTripper
+	attempts *atomic.Int32
+}
+
+func TestRetryTransportRetriesStatus(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected replayed body of payload, received %s", string(body))
+		}
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(RetryOptions{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: 5 * time.Millisecond})
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodDelete, server.URL, nil)
+	requireNoError(t, err)
+	// Mirror client-go, which sets Body without GetBody.
+	req.Body = io.NopCloser(strings.NewReader("payload"))
+	res, err := client.Do(req)
+	requireNoError(t, err)
+	_ = res.Body.Close()
+	requireEqual(t, res.StatusCode, http.StatusOK)
+	requireEqual(t, attempts.Load(), 3)
+}
+
+func TestRetryTransportExhaustsAttempts(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(RetryOptions{MaxAttempts: 2, MinWait: time.Millisecond, MaxWait: 5 * time.Millisecond})
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+	requireNoError(t, err)
+	res, err := client.Do(req)
+	requireNoError(t, err)
+	_ = res.Body.Close()
+	requireEqual(t, res.StatusCode, http.StatusTooManyRequests)
+	requireEqual(t, attempts.Load(), 2)
+}
+
+func TestRetryTransportSkipsNonIdempotent(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(RetryOptions{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: 5 * time.Millisecond})
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, nil)
+	requireNoError(t, err)
+	req.Body = io.NopCloser(strings.NewReader("payload"))
+	res, err := client.Do(req)
+	requireNoError(t, err)
+	_ = res.Body.Close()
+	requireEqual(t, res.StatusCode, http.StatusBadGateway)
+	requireEqual(t, attempts.Load(), 1)
+}
+
+func TestRetryTransportSkipsPutOnceWritten(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	client := newRetryTestClient(RetryOptions{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: 5 * time.Millisecond})
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPut, server.URL, nil)
+	requireNoError(t, err)
+	req.Body = io.NopCloser(strings.NewReader("payload"))
+	res, err := client.Do(req)
+	requireNoError(t, err)
+	_ = res.Body.Close()
+	requireEqual(t, res.StatusCode, http.StatusBadGateway)
+	requireEqual(t, attempts.Load(), 1)
+}
+
+func TestRetryTransportRetriesBeforeWritten(t *testing.T) {
+	// Closed server, so that connections are refused before any request is written.
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+	var attempts atomic.Int32
+	client := &http.Client{
+		Transport: &retryTransport{
+			inner:   &attemptCountingTransport{inner: http.DefaultTransport, attempts: &attempts},
+			options: RetryOptions{MaxAttempts: 3, MinWait: time.Millisecond, MaxWait: 5 * time.Millisecond},
+		},
+	}
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, nil)
+	requireNoError(t, err)
+	req.Body = io.NopCloser(strings.NewReader("payload"))
+	res, err := client.Do(req)
+	if err == nil {
+		_ = res.Body.Close()
+	}
+	requireError(t, err, "connection refused")
+	requireEqual(t, attempts.Load(), 3)
+}
+
+func (t *attemptCountingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.attempts.Add(1)
+	return t.inner.RoundTrip(req)
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	retry := retryTransport{
+		inner:   http.DefaultTransport,
+		options: RetryOptions{MaxAttempts: 5, MinWait: time.Second, MaxWait: 4 * time.Second},
+	}
+	for attempt, expected := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 4 * time.Second} {
+		wait := retry.backoff(attempt, nil)
+		requireEqual(t, wait <= expected, true)
+		requireEqual(t, wait >= expected/2, true)
+	}
+	{
+		res := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+		requireEqual(t, retry.backoff(1, res), 3*time.Second)
+	}
+	{
+		res := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+		requireEqual(t, retry.backoff(1, res), 4*time.Second)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	{
+		wait, ok := parseRetryAfter("")
+		requireEqual(t, ok, false)
+		requireEqual(t, wait, 0)
+	}
+	{
+		wait, ok := parseRetryAfter("7")
+		requireEqual(t, ok, true)
+		requireEqual(t, wait, 7*time.Second)
+	}
+	{
+		_, ok := parseRetryAfter("-1")
+		requireEqual(t, ok, false)
+	}
+	{
+		wait, ok := parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+		requireEqual(t, ok, true)
+		requireEqual(t, wait, 0)
+	}
+	{
+		_, ok := parseRetryAfter("soon")
+		requireEqual(t, ok, false)
+	}
+}
+
+func TestLimitTransportConcurrency(t *testing.T) {
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for previous := maxInFlight.Load(); current > previous && !maxInFlight.CompareAndSwap(previous, current); {
+			previous = maxInFlight.Load()
+		}
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLimitTransport(http.DefaultTransport, LimitOptions{MaxConcurrentRequests: 2, RequestsPerSecond: 0})}
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+			requireNoError(t, err)
+			res, err := client.Do(req)
+			requireNoError(t, err)
+			if res != nil {
+				_ = res.Body.Close()
+			}
+		})
+	}
+	wg.Wait()
+	requireEqual(t, maxInFlight.Load(), 2)
+}
+
+func TestLimitTransportRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newLimitTransport(http.DefaultTransport, LimitOptions{MaxConcurrentRequests: 0, RequestsPerSecond: 20})}
+	start := time.Now()
+	for range 4 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, nil)
+		requireNoError(t, err)
+		res, err := client.Do(req)
+		requireNoError(t, err)
+		_ = res.Body.Close()
+	}
+	// First request is immediate, with the remaining 3 spaced by 50ms.
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("Expected requests to be rate limited to at least 150ms, received %s", elapsed)
+	}
+}
+
+func TestLimitTransportDisabled(t *testing.T) {
+	inner := &http.Transport{}
+	requireEqual(t, newLimitTransport(inner, LimitOptions{MaxConcurrentRequests: 0, RequestsPerSecond: 0}) == http.RoundTripper(inner), true)
+}
+
+func newRetryTestClient(options RetryOptions) *http.Client {
+	return &http.Client{
+		Transport: &retryTransport{
+			inner:   http.DefaultTransport,
+			options: options,
+		},
+	}
+}
+
+func TestNewHTTPClientClonesTransport(t *testing.T) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, received %T", http.DefaultTransport)
+	}
+	// Cloning configures HTTP/2 on the default transport once, which may set its TLS configuration, so is done before capturing it.
+	_ = defaultTransport.Clone()
+	defaultTLS := defaultTransport.TLSClientConfig
+	proxyURL, err := url.Parse("http://proxy.example.com:3128")
+	requireNoError(t, err)
+	options := HTTPClientOptions{
+		ProxyURL: proxyURL,
+		TLS: TLSOptions{
+			ServerName:         "dtrack.example.com",
+			MinVersion:         tls.VersionTLS12,
+			InsecureSkipVerify: true,
+		},
+		Timeout: time.Minute,
+	}
+	client, err := NewHTTPClient(options)
+	requireNoError(t, err)
+	other, err := NewHTTPClient(HTTPClientOptions{TLS: TLSOptions{MinVersion: tls.VersionTLS13}})
+	requireNoError(t, err)
+
+	inner := innerHTTPTransport(t, client)
+	requireEqual(t, inner.TLSClientConfig.MinVersion, tls.VersionTLS12)
+	requireEqual(t, inner.TLSClientConfig.ServerName, "dtrack.example.com")
+	requireEqual(t, inner.TLSClientConfig.InsecureSkipVerify, true)
+	proxy, err := inner.Proxy(&http.Request{URL: &url.URL{Scheme: "https", Host: "dtrack.example.com"}})
+	requireNoError(t, err)
+	requireEqual(t, proxy.String(), "http://proxy.example.com:3128")
+
+	otherInner := innerHTTPTransport(t, other)
+	requireEqual(t, otherInner.TLSClientConfig.MinVersion, tls.VersionTLS13)
+	requireEqual(t, otherInner.TLSClientConfig.InsecureSkipVerify, false)
+	requireEqual(t, inner != otherInner, true)
+	requireEqual(t, defaultTransport.TLSClientConfig == defaultTLS, true)
+}
+
+func innerHTTPTransport(t *testing.T, client *http.Client) *http.Transport {
+	t.Helper()
+	retry, ok := client.Transport.(*retryTransport)
+	if !ok {
+		t.Fatalf("Expected *retryTransport, received %T", client.Transport)
+	}
+	timeout, ok := retry.inner.(*timeoutTransport)
+	if !ok {
+		t.Fatalf("Expected *timeoutTransport, received %T", retry.inner)
+	}
+	headers, ok := timeout.inner.(*transport)
+	if !ok {
+		t.Fatalf("Expected *transport, received %T", timeout.inner)
+	}
+	inner, ok := headers.inner.(*http.Transport)
+	if !ok {
+		t.Fatalf("Expected *http.Transport, received %T", headers.inner)
+	}
+	return inner
+}
+
+func TestTokenTransportRefreshesOnUnauthorized(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("Expected replayed body of payload, received %s", string(body))
+		}
+		if r.Header.Get("Authorization") != "Bearer second" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var fetches atomic.Int32
+	tokens := []string{"first", "second"}
+	client := &http.Client{
+		Transport: newTokenTransport(http.DefaultTransport, func(_ context.Context) (string, time.Time, error) {
+			fetched := fetches.Add(1)
+			return tokens[fetched-1], time.Time{}, nil
+		}),
+	}
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, nil)
+	requireNoError(t, err)
+	req.Body = io.NopCloser(strings.NewReader("payload"))
+	res, err := client.Do(req)
+	requireNoError(t, err)
+	_ = res.Body.Close()
+	requireEqual(t, res.StatusCode, http.StatusOK)
+	requireEqual(t, fetches.Load(), 2)
+
+	// Cached token is reused.
+	req, err = http.NewRequestWithContext(t.Context(), http.MethodPost, server.URL, strings.NewReader("payload"))
+	requireNoError(t, err)
+	res, err = client.Do(req)
+	requireNoError(t, err)
+	_ = res.Body.Close()
+	requireEqual(t, res.StatusCode, http.StatusOK)
+	requireEqual(t, fetches.Load(), 2)
+}
+
+func TestTokenTransportRefreshesBeforeExpiry(t *testing.T) {
+	var fetches atomic.Int32
+	transport := newTokenTransport(http.DefaultTransport, func(_ context.Context) (string, time.Time, error) {
+		fetches.Add(1)
+		// Expires within the margin, so is refreshed on every use.
+		return "token", time.Now().Add(TokenExpiryMargin / 2), nil
+	})
+	_, err := transport.currentToken(t.Context())
+	requireNoError(t, err)
+	_, err = transport.currentToken(t.Context())
+	requireNoError(t, err)
+	requireEqual(t, fetches.Load(), 2)
+}
+
+func TestJWTExpiry(t *testing.T) {
+	payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin","exp":1700000000}`))
+	requireEqual(t, jwtExpiry("header."+payload+".signature").Unix(), 1700000000)
+	requireEqual(t, jwtExpiry("not-a-jwt").IsZero(), true)
+	requireEqual(t, jwtExpiry("header.!!!.signature").IsZero(), true)
+	noExpiry := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"admin"}`))
+	requireEqual(t, jwtExpiry("header."+noExpiry+".signature").IsZero(), true)
+}
+
+func TestClientCredentialsTokenFetch(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		// Client credentials are form-urlencoded before Basic authentication, per RFC 6749 Section 2.3.1.
+		clientID, encodedSecret, ok := r.BasicAuth()
+		clientSecret, _ := url.QueryUnescape(encodedSecret)
+		if !ok || clientID != "client" || clientSecret != "s3cr%t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requireNoError(t, r.ParseForm())
+		requireEqual(t, r.PostForm.Get("grant_type"), "client_credentials")
+		requireEqual(t, r.PostForm.Get("scope"), "openid dtrack")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"idp-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer tokenServer.Close()
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer idp-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer apiServer.Close()
+
+	fetch := newClientCredentialsTokenFetch(http.DefaultClient, tokenServer.URL, "client", "s3cr%t", []string{"openid", "dtrack"})
+	token, expiry, err := fetch(t.Context())
+	requireNoError(t, err)
+	requireEqual(t, token, "idp-token")
+	requireEqual(t, expiry.After(time.Now().Add(59*time.Minute)), true)
+
+	client := &http.Client{Transport: newTokenTransport(http.DefaultTransport, fetch)}
+	for range 3 {
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, apiServer.URL, nil)
+		requireNoError(t, err)
+		res, err := client.Do(req)
+		requireNoError(t, err)
+		_ = res.Body.Close()
+		requireEqual(t, res.StatusCode, http.StatusOK)
+	}
+	// One for the direct fetch, and one cached within the transport.
+	requireEqual(t, tokenRequests.Load(), 2)
+
+	badFetch := newClientCredentialsTokenFetch(http.DefaultClient, tokenServer.URL, "client", "wrong", nil)
+	_, _, err = badFetch(t.Context())
+	requireError(t, err, "^token endpoint responded with status 401")
+}
+
+func TestParsePKCS12(t *testing.T) {
+	bundle, err := os.ReadFile("testdata/mtls/bundle.p12")
+	requireNoError(t, err)
+
+	certificate, err := parsePKCS12(bundle, "test-password")
+	requireNoError(t, err)
+	requireEqual(t, len(certificate.Certificate), 1)
+	requireEqual(t, certificate.Leaf.Subject.CommonName, "terraform-provider-dependencytrack-test")
+
+	_, err = parsePKCS12(bundle, "wrong-password")
+	requireError(t, err, ".+")
+}
@@ -0,0 +1,241 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// HTTPLogSubsystem is the tflog subsystem for requests to DependencyTrack API.
+	HTTPLogSubsystem = "dependencytrack_http"
+	// EnvHTTPLog opts into logging of requests to DependencyTrack API, when set to 'DEBUG' or 'TRACE'.
+	EnvHTTPLog = "TF_LOG_PROVIDER_DEPENDENCYTRACK_HTTP"
+
+	httpLogBodyLimit  = 4096
+	httpLogParseLimit = 1 << 20
+	redactedValue     = "[REDACTED]"
+)
+
+var (
+	redactedHeaders = []string{"X-Api-Key", "Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+	// Lowercase JSON fields and form values, which may hold secrets.
+	redactedFields = []string{
+		"password", "newpassword", "confirmpassword",
+		"key", "apikey", "secret", "clientsecret", "client_secret",
+		"token", "access_token", "refresh_token", "id_token",
+	}
+)
+
+// Body of which the start was read to be logged, which streams that start, followed by the remainder.
+type peekedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// Performs the request, logging the method, URL, status, latency, headers and bodies with secrets redacted.
+func (t *transport) logRoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), HTTPLogSubsystem, tflog.WithLevelFromEnv(EnvHTTPLog))
+	if values := t.headerValues(); len(values) > 0 {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, HTTPLogSubsystem, values...)
+	}
+	requestBody, requestComplete, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{
+		"method":          req.Method,
+		"url":             req.URL.Redacted(),
+		"request_headers": t.redactHeaders(req.Header),
+		"request_body":    redactBody(req.Header.Get("Content-Type"), requestBody, requestComplete),
+	}
+	start := time.Now()
+	res, err := t.inner.RoundTrip(req)
+	fields["latency"] = time.Since(start).String()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, HTTPLogSubsystem, "DependencyTrack API request failed", fields)
+		return nil, err
+	}
+	responseBody, responseComplete, readErr := readResponseBody(res)
+	if readErr != nil {
+		return nil, readErr
+	}
+	fields["status"] = res.StatusCode
+	fields["response_headers"] = t.redactHeaders(res.Header)
+	if strings.HasSuffix(req.URL.Path, "/login") && !isStructuredBody(res.Header.Get("Content-Type")) {
+		// Login endpoints respond with a plain text token.
+		fields["response_body"] = redactedValue
+	} else {
+		fields["response_body"] = redactBody(res.Header.Get("Content-Type"), responseBody, responseComplete)
+	}
+	tflog.SubsystemDebug(ctx, HTTPLogSubsystem, "DependencyTrack API request", fields)
+	return res, nil
+}
+
+func (t *transport) headerValues() []string {
+	return Filter(Map(t.headers, func(header Header) string { return header.Value }), func(value string) bool {
+		return value != ""
+	})
+}
+
+func (t *transport) redactHeaders(headers http.Header) map[string]string {
+	configured := Map(t.headers, func(header Header) string { return http.CanonicalHeaderKey(header.Name) })
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if slices.Contains(redactedHeaders, name) || slices.Contains(configured, name) {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+// Reads up to httpLogParseLimit bytes of the request body, leaving the whole body readable for the inner transport.
+// Returns whether the content read is the whole body.
+func readRequestBody(req *http.Request) ([]byte, bool, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, true, nil
+	}
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, false, err
+		}
+		content, _, complete, err := peekBody(body)
+		_ = body.Close()
+		return content, complete, err
+	}
+	content, body, complete, err := peekBody(req.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	req.Body = body
+	return content, complete, nil
+}
+
+// Reads up to httpLogParseLimit bytes of the response body, leaving the whole body readable for the caller.
+// Returns whether the content read is the whole body.
+func readResponseBody(res *http.Response) ([]byte, bool, error) {
+	content, body, complete, err := peekBody(res.Body)
+	if err != nil {
+		return nil, false, err
+	}
+	res.Body = body
+	return content, complete, nil
+}
+
+// Reads up to httpLogParseLimit bytes of body, so that large bodies, such as BOMs, are not held in memory to be logged.
+// The returned body streams the content read, followed by the remainder of body.
+func peekBody(body io.ReadCloser) ([]byte, io.ReadCloser, bool, error) {
+	content, err := io.ReadAll(io.LimitReader(body, httpLogParseLimit+1))
+	if err != nil {
+		_ = body.Close()
+		return nil, nil, false, err
+	}
+	if len(content) <= httpLogParseLimit {
+		_ = body.Close()
+		return content, io.NopCloser(bytes.NewReader(content)), true, nil
+	}
+	return content[:httpLogParseLimit], &peekedBody{Reader: io.MultiReader(bytes.NewReader(content), body), Closer: body}, false, nil
+}
+
+func isStructuredBody(contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") || mediaType == "application/x-www-form-urlencoded"
+}
+
+// Redacts secrets from a JSON or form body, then truncates it. Other bodies are only truncated.
+// Incomplete bodies, being only the start of a larger body, cannot be parsed, so JSON and forms are omitted.
+func redactBody(contentType string, body []byte, complete bool) string {
+	if len(body) == 0 {
+		return ""
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "application/x-www-form-urlencoded":
+		if !complete {
+			return fmt.Sprintf("[more than %d bytes of form, too large to redact]", len(body))
+		}
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return fmt.Sprintf("[%d bytes of unparsable form]", len(body))
+		}
+		for name := range values {
+			if slices.Contains(redactedFields, strings.ToLower(name)) {
+				values.Set(name, redactedValue)
+			}
+		}
+		return truncateBody(values.Encode(), true)
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json") || json.Valid(body):
+		if !complete {
+			return fmt.Sprintf("[more than %d bytes of JSON, too large to redact]", len(body))
+		}
+		var value any
+		if err := json.Unmarshal(body, &value); err != nil {
+			return fmt.Sprintf("[%d bytes of unparsable JSON]", len(body))
+		}
+		redacted, err := json.Marshal(redactJSON(value))
+		if err != nil {
+			return fmt.Sprintf("[%d bytes of JSON]", len(body))
+		}
+		return truncateBody(string(redacted), true)
+	default:
+		return truncateBody(string(body), complete)
+	}
+}
+
+func redactJSON(value any) any {
+	switch typed := value.(type) {
+	case map[string]any:
+		// Properties, such as config and project properties, hold secrets within 'propertyValue' when encrypted.
+		encrypted := typed["propertyType"] == PropertyTypeEncryptedString
+		for name, field := range typed {
+			if field != nil && (slices.Contains(redactedFields, strings.ToLower(name)) || (encrypted && name == "propertyValue")) {
+				typed[name] = redactedValue
+				continue
+			}
+			typed[name] = redactJSON(field)
+		}
+		return typed
+	case []any:
+		for i, item := range typed {
+			typed[i] = redactJSON(item)
+		}
+		return typed
+	default:
+		return value
+	}
+}
+
+func truncateBody(body string, complete bool) string {
+	if complete && len(body) <= httpLogBodyLimit {
+		return body
+	}
+	if !complete {
+		// Incomplete bodies are httpLogParseLimit bytes, so always exceed httpLogBodyLimit.
+		return body[:httpLogBodyLimit] + fmt.Sprintf("...[more than %d bytes truncated]", len(body)-httpLogBodyLimit)
+	}
+	return body[:httpLogBodyLimit] + fmt.Sprintf("...[%d bytes truncated]", len(body)-httpLogBodyLimit)
+}
+
+// Whether the level of EnvHTTPLog logs requests, which are logged at DEBUG.
+func httpLogEnabled() bool {
+	switch strings.ToUpper(strings.TrimSpace(os.Getenv(EnvHTTPLog))) {
+	case "DEBUG", "TRACE":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestRedactBody(t *testing.T) {
+	requireEqual(t, redactBody("application/json", []byte(`{"username":"admin","password":"secret","apiKeys":[{"key":"odt_secret","comment":"ci"}]}`), true),
+		`{"apiKeys":[{"comment":"ci","key":"[REDACTED]"}],"password":"[REDACTED]","username":"admin"}`)
+	requireEqual(t, redactBody("application/json; charset=utf-8", []byte(`[{"propertyName":"a","propertyType":"ENCRYPTEDSTRING","propertyValue":"secret"},{"propertyName":"b","propertyType":"STRING","propertyValue":"visible"}]`), true),
+		`[{"propertyName":"a","propertyType":"ENCRYPTEDSTRING","propertyValue":"[REDACTED]"},{"propertyName":"b","propertyType":"STRING","propertyValue":"visible"}]`)
+	requireEqual(t, redactBody("application/x-www-form-urlencoded", []byte("username=admin&password=secret"), true), "password=%5BREDACTED%5D&username=admin")
+	requireEqual(t, redactBody("text/plain", []byte("plain"), true), "plain")
+	requireEqual(t, redactBody("", nil, true), "")
+
+	truncated := redactBody("text/plain", bytes.Repeat([]byte("a"), httpLogBodyLimit+10), true)
+	requireEqual(t, strings.HasSuffix(truncated, "...[10 bytes truncated]"), true)
+	requireEqual(t, len(truncated), httpLogBodyLimit+len("...[10 bytes truncated]"))
+
+	partial := bytes.Repeat([]byte("a"), httpLogParseLimit)
+	requireEqual(t, redactBody("application/json", partial, false), fmt.Sprintf("[more than %d bytes of JSON, too large to redact]", httpLogParseLimit))
+	requireEqual(t, redactBody("application/x-www-form-urlencoded", partial, false), fmt.Sprintf("[more than %d bytes of form, too large to redact]", httpLogParseLimit))
+	requireEqual(t, strings.HasSuffix(redactBody("text/plain", partial, false), fmt.Sprintf("...[more than %d bytes truncated]", httpLogParseLimit-httpLogBodyLimit)), true)
+}
+
+func TestHTTPLogEnabled(t *testing.T) {
+	for value, expected := range map[string]bool{"": false, "OFF": false, "INFO": false, "WARN": false, "debug": true, "TRACE": true} {
+		t.Setenv(EnvHTTPLog, value)
+		requireEqual(t, httpLogEnabled(), expected)
+	}
+}
+
+func TestPeekBody(t *testing.T) {
+	{
+		content, body, complete, err := peekBody(io.NopCloser(strings.NewReader("small")))
+		requireNoError(t, err)
+		requireEqual(t, string(content), "small")
+		requireEqual(t, complete, true)
+		all, err := io.ReadAll(body)
+		requireNoError(t, err)
+		requireEqual(t, string(all), "small")
+	}
+	{
+		large := bytes.Repeat([]byte("0123456789"), httpLogParseLimit/5)
+		content, body, complete, err := peekBody(io.NopCloser(bytes.NewReader(large)))
+		requireNoError(t, err)
+		requireEqual(t, len(content), httpLogParseLimit)
+		requireEqual(t, complete, false)
+		all, err := io.ReadAll(body)
+		requireNoError(t, err)
+		requireEqual(t, bytes.Equal(all, large), true)
+		requireNoError(t, body.Close())
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	tr := &transport{inner: nil, headers: []Header{{Name: "proxy-token", Value: "configured"}}, logging: true}
+	redacted := tr.redactHeaders(http.Header{
+		"X-Api-Key":     {"odt_secret"},
+		"Authorization": {"Bearer secret"},
+		"Proxy-Token":   {"configured"},
+		"Accept":        {"application/json", "text/plain"},
+	})
+	requireEqual(t, redacted["X-Api-Key"], redactedValue)
+	requireEqual(t, redacted["Authorization"], redactedValue)
+	requireEqual(t, redacted["Proxy-Token"], redactedValue)
+	requireEqual(t, redacted["Accept"], "application/json, text/plain")
+}
+
+func TestTransportLogsRequests(t *testing.T) {
+	t.Setenv(EnvHTTPLog, "DEBUG")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != `{"password":"request-secret"}` {
+			t.Errorf("Expected unmodified request body, received %s", string(body))
+		}
+		if r.URL.Path == "/api/v1/user/login" {
+			w.Header().Set("Content-Type", "text/plain")
+			_, _ = w.Write([]byte("token-secret"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"visible","key":"response-secret"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	client := &http.Client{Transport: &transport{
+		inner:   http.DefaultTransport,
+		headers: []Header{{Name: "X-Custom", Value: "header-secret"}},
+		logging: true,
+	}}
+	for _, endpoint := range []string{"/api/v1/project", "/api/v1/user/login"} {
+		req, err := http.NewRequestWithContext(tflogtest.RootLogger(t.Context(), &output), http.MethodPost, server.URL+endpoint,
+			strings.NewReader(`{"password":"request-secret"}`))
+		requireNoError(t, err)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Api-Key", "odt_secret")
+		res, err := client.Do(req)
+		requireNoError(t, err)
+		body, err := io.ReadAll(res.Body)
+		requireNoError(t, err)
+		_ = res.Body.Close()
+		if endpoint == "/api/v1/user/login" {
+			requireEqual(t, string(body), "token-secret")
+		}
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"request-secret", "response-secret", "token-secret", "header-secret", "odt_secret"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("Expected %s to be redacted, received logs: %s", secret, logs)
+		}
+	}
+	for _, expected := range []string{`"@module":"provider.dependencytrack_http"`, `"status":200`, `"method":"POST"`, "visible", "latency"} {
+		if !strings.Contains(logs, expected) {
+			t.Errorf("Expected logs to contain %s, received: %s", expected, logs)
+		}
+	}
+}
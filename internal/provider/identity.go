@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type (
+	// Typed identity of a resource, as an ordered list of attributes.
+	// In order, the attribute values joined by '/' form the import id of the resource.
+	resourceIdentity []identityAttribute
+
+	identityAttribute struct {
+		// Name of the identity attribute.
+		Name string
+		// Path of the state attribute, holding the value of the identity attribute.
+		State       path.Path
+		Description string
+	}
+)
+
+// Schema returns the identity schema, with all attributes required for import.
+func (ri resourceIdentity) Schema() identityschema.Schema {
+	attributes := make(map[string]identityschema.Attribute, len(ri))
+	for _, attribute := range ri {
+		attributes[attribute.Name] = identityschema.StringAttribute{
+			Description:       attribute.Description,
+			RequiredForImport: true,
+		}
+	}
+	return identityschema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// Set copies identity attributes from state. Used at the end of Create and Update, and at the start of Read from
+// the prior state, so that identity is set even when Read removes the resource. Identity attributes all require replace.
+func (ri resourceIdentity) Set(ctx context.Context, state tfsdk.State, identity *tfsdk.ResourceIdentity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil || state.Raw.IsNull() {
+		return diags
+	}
+	for _, attribute := range ri {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, attribute.State, &value)...)
+		if diags.HasError() {
+			return diags
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(attribute.Name), value)...)
+	}
+	return diags
+}
+
+// ImportID returns the import id, either as given, or joined from identity attributes when importing by identity.
+func (ri resourceIdentity) ImportID(ctx context.Context, req resource.ImportStateRequest) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if req.ID != "" || req.Identity == nil {
+		return req.ID, diags
+	}
+	parts := make([]string, 0, len(ri))
+	for _, attribute := range ri {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(attribute.Name), &value)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		if value.ValueString() == "" {
+			diags.AddAttributeError(
+				path.Root(attribute.Name),
+				"Missing identity attribute",
+				"Within Import, identity attribute '"+attribute.Name+"' must be set.",
+			)
+			continue
+		}
+		parts = append(parts, value.ValueString())
+	}
+	return strings.Join(parts, "/"), diags
+}
+
+// ImportStatePassthrough sets the import id, from either the id or identity, to the 'id' attribute.
+func (ri resourceIdentity) ImportStatePassthrough(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, diags := ri.ImportID(ctx, req)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Identity of a resource with a UUID 'id'.
+func uuidIdentity(description string) resourceIdentity {
+	return resourceIdentity{
+		{Name: "id", State: path.Root("id"), Description: description},
+	}
+}
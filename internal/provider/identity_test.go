@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var testIdentity = resourceIdentity{
+	{Name: "project_id", State: path.Root("project"), Description: "UUID of the Project."},
+	{Name: "name", State: path.Root("name"), Description: "Name."},
+}
+
+func newTestIdentity(ctx context.Context, values map[string]tftypes.Value) *tfsdk.ResourceIdentity {
+	identitySchema := testIdentity.Schema()
+	identityType := identitySchema.Type().TerraformType(ctx)
+	raw := tftypes.NewValue(identityType, nil)
+	if values != nil {
+		raw = tftypes.NewValue(identityType, values)
+	}
+	return &tfsdk.ResourceIdentity{Schema: identitySchema, Raw: raw}
+}
+
+func TestResourceIdentitySet(t *testing.T) {
+	ctx := t.Context()
+	stateSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"project": schema.StringAttribute{Required: true},
+		"name":    schema.StringAttribute{Required: true},
+		"value":   schema.StringAttribute{Optional: true},
+	}}
+	stateType := stateSchema.Type().TerraformType(ctx)
+	state := tfsdk.State{Schema: stateSchema, Raw: tftypes.NewValue(stateType, map[string]tftypes.Value{
+		"project": tftypes.NewValue(tftypes.String, "c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		"name":    tftypes.NewValue(tftypes.String, "Name"),
+		"value":   tftypes.NewValue(tftypes.String, "Value"),
+	})}
+
+	identity := newTestIdentity(ctx, nil)
+	diags := testIdentity.Set(ctx, state, identity)
+	if diags.HasError() {
+		t.Fatalf("Expected no error, received %v", diags)
+	}
+	var projectID, name string
+	identity.GetAttribute(ctx, path.Root("project_id"), &projectID)
+	identity.GetAttribute(ctx, path.Root("name"), &name)
+	requireEqual(t, projectID, "c82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	requireEqual(t, name, "Name")
+
+	// Removed from state.
+	identity = newTestIdentity(ctx, nil)
+	diags = testIdentity.Set(ctx, tfsdk.State{Schema: stateSchema, Raw: tftypes.NewValue(stateType, nil)}, identity)
+	requireEqual(t, diags.HasError(), false)
+	requireEqual(t, identity.Raw.IsNull(), true)
+
+	// Identity unsupported.
+	requireEqual(t, testIdentity.Set(ctx, state, nil).HasError(), false)
+}
+
+func TestResourceIdentityImportID(t *testing.T) {
+	ctx := t.Context()
+	id, diags := testIdentity.ImportID(ctx, resource.ImportStateRequest{ID: "Given", Identity: nil})
+	requireEqual(t, diags.HasError(), false)
+	requireEqual(t, id, "Given")
+
+	id, diags = testIdentity.ImportID(ctx, resource.ImportStateRequest{ID: "", Identity: newTestIdentity(ctx, map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, "c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		"name":       tftypes.NewValue(tftypes.String, "Name"),
+	})})
+	requireEqual(t, diags.HasError(), false)
+	requireEqual(t, id, "c82d6f01-a7a4-41d6-9b03-4f06497f575b/Name")
+
+	_, diags = testIdentity.ImportID(ctx, resource.ImportStateRequest{ID: "", Identity: newTestIdentity(ctx, map[string]tftypes.Value{
+		"project_id": tftypes.NewValue(tftypes.String, "c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		"name":       tftypes.NewValue(tftypes.String, nil),
+	})})
+	requireEqual(t, diags.HasError(), true)
+	requireEqual(t, diags.Errors()[0].Summary(), "Missing identity attribute")
+}
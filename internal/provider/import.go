@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+)
+
+var ErrAmbiguousImportID = errors.New("import id is ambiguous")
+
+// Resolves an import id, which is either a UUID, or a natural key in keyFormat.
+// Natural keys are resolved by resolve, which returns the UUIDs of all matching items, so that ambiguity is reported.
+func ResolveImportID(id, keyFormat string, resolve func(key string) ([]uuid.UUID, error)) (uuid.UUID, error) {
+	if parsed, err := uuid.Parse(id); err == nil {
+		return parsed, nil
+	}
+	if id == "" {
+		return uuid.Nil, fmt.Errorf("expected id as <UUID> or %s, received empty id", keyFormat)
+	}
+	matches, err := resolve(id)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("unable to resolve '%s' as %s: %w", id, keyFormat, err)
+	}
+	switch len(matches) {
+	case 0:
+		return uuid.Nil, fmt.Errorf("unable to resolve '%s' as %s: %w", id, keyFormat, ErrNotFound)
+	case 1:
+		return matches[0], nil
+	default:
+		return uuid.Nil, fmt.Errorf("%w: '%s' as %s matches %d items (%s), import by UUID instead",
+			ErrAmbiguousImportID, id, keyFormat, len(matches),
+			strings.Join(Map(matches, func(match uuid.UUID) string { return match.String() }), ", "))
+	}
+}
+
+// Splits a project natural key of '<Name>@<Version>' or '<Name>'. The last '@' separates the version,
+// so that names may contain '@', such as npm scopes.
+func parseProjectNaturalKey(key string) (string, *string) {
+	index := strings.LastIndex(key, "@")
+	if index <= 0 {
+		return key, nil
+	}
+	version := key[index+1:]
+	return key[:index], &version
+}
+
+// Resolves a project import id of '<UUID>', '<Name>@<Version>' or '<Name>'.
+// Without a version, the name must match a single project.
+func resolveProjectImportID(ctx context.Context, client *dtrack.Client, id string) (uuid.UUID, error) {
+	return ResolveImportID(id, "<Name>@<Version>", func(key string) ([]uuid.UUID, error) {
+		name, version := parseProjectNaturalKey(key)
+		if version != nil {
+			project, err := client.Project.Lookup(ctx, name, *version)
+			if err != nil {
+				if IsNotFound(err) {
+					return nil, nil
+				}
+				return nil, err
+			}
+			return []uuid.UUID{project.UUID}, nil
+		}
+		projects, err := client.Project.GetProjectsForName(ctx, name, false, false)
+		if err != nil {
+			return nil, err
+		}
+		projects = Filter(projects, func(project dtrack.Project) bool { return project.Name == name })
+		return Map(projects, func(project dtrack.Project) uuid.UUID { return project.UUID }), nil
+	})
+}
+
+// Returns the UUIDs of all items matching key by name.
+func uuidsByName[T any](items []T, key string, name func(T) string, id func(T) uuid.UUID) []uuid.UUID {
+	return Map(Filter(items, func(item T) bool { return name(item) == key }), id)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestParseProjectNaturalKey(t *testing.T) {
+	name, version := parseProjectNaturalKey("Project@1.0.0")
+	requireEqual(t, name, "Project")
+	requireEqual(t, *version, "1.0.0")
+
+	name, version = parseProjectNaturalKey("@scope/package@2.0.0")
+	requireEqual(t, name, "@scope/package")
+	requireEqual(t, *version, "2.0.0")
+
+	name, version = parseProjectNaturalKey("Project@")
+	requireEqual(t, name, "Project")
+	requireEqual(t, *version, "")
+
+	name, version = parseProjectNaturalKey("Project")
+	requireEqual(t, name, "Project")
+	requireNil(t, version)
+
+	name, version = parseProjectNaturalKey("@scope")
+	requireEqual(t, name, "@scope")
+	requireNil(t, version)
+}
+
+func TestResolveImportID(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	unused := func(string) ([]uuid.UUID, error) {
+		t.Fatal("Expected UUID to not be resolved as a natural key")
+		return nil, nil
+	}
+	id, err := ResolveImportID(first.String(), "<Name>", unused)
+	requireNoError(t, err)
+	requireEqual(t, id.String(), first.String())
+
+	id, err = ResolveImportID("Name", "<Name>", func(key string) ([]uuid.UUID, error) {
+		requireEqual(t, key, "Name")
+		return []uuid.UUID{second}, nil
+	})
+	requireNoError(t, err)
+	requireEqual(t, id.String(), second.String())
+
+	_, err = ResolveImportID("Name", "<Name>", func(string) ([]uuid.UUID, error) { return nil, nil })
+	requireError(t, err, "unable to resolve 'Name' as <Name>: did not find item")
+	requireEqual(t, IsNotFound(err), true)
+
+	_, err = ResolveImportID("Name", "<Name>", func(string) ([]uuid.UUID, error) { return []uuid.UUID{first, second}, nil })
+	requireError(t, err, "'Name' as <Name> matches 2 items .*, import by UUID instead")
+	requireEqual(t, errors.Is(err, ErrAmbiguousImportID), true)
+
+	_, err = ResolveImportID("Name", "<Name>", func(string) ([]uuid.UUID, error) { return nil, errors.New("api") })
+	requireError(t, err, "unable to resolve 'Name' as <Name>: api")
+
+	_, err = ResolveImportID("", "<Name>", unused)
+	requireError(t, err, "received empty id")
+}
@@ -17,6 +17,7 @@ import (
 var (
 	_ resource.Resource              = &ldapTeamMappingResource{}
 	_ resource.ResourceWithConfigure = &ldapTeamMappingResource{}
+	_ resource.ResourceWithIdentity  = &ldapTeamMappingResource{}
 )
 
 type (
@@ -69,6 +70,12 @@ func (*ldapTeamMappingResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+var ldapTeamMappingIdentity = uuidIdentity("UUID of the LDAP Team Mapping.")
+
+func (*ldapTeamMappingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ldapTeamMappingIdentity.Schema()
+}
+
 func (r *ldapTeamMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ldapTeamMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -113,6 +120,7 @@ func (r *ldapTeamMappingResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(ldapTeamMappingIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created LDAP Team Mapping", map[string]any{
 		"id":                 plan.ID.ValueString(),
 		"team":               plan.Team.ValueString(),
@@ -121,6 +129,7 @@ func (r *ldapTeamMappingResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *ldapTeamMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(ldapTeamMappingIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state ldapTeamMappingResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -149,6 +158,9 @@ func (r *ldapTeamMappingResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	mappedGroups, err := r.client.LDAP.GetTeamMappings(ctx, team)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String(), "team": team.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to retrieve team mappings",
@@ -159,7 +171,9 @@ func (r *ldapTeamMappingResource) Read(ctx context.Context, req resource.ReadReq
 	mappingInfo, err := Find(mappedGroups, func(mapping dtrack.MappedLdapGroup) bool {
 		return mapping.UUID == id
 	})
-
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String(), "team": team.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to locate ldap mapping",
@@ -230,6 +244,7 @@ func (*ldapTeamMappingResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(ldapTeamMappingIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated LDAP Team Mapping", map[string]any{
 		"id":                 state.ID.ValueString(),
 		"team":               state.Team.ValueString(),
@@ -18,6 +18,7 @@ var (
 	_ resource.Resource                = &ldapUserResource{}
 	_ resource.ResourceWithConfigure   = &ldapUserResource{}
 	_ resource.ResourceWithImportState = &ldapUserResource{}
+	_ resource.ResourceWithIdentity    = &ldapUserResource{}
 )
 
 type (
@@ -62,6 +63,14 @@ func (*ldapUserResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+var ldapUserIdentity = resourceIdentity{
+	{Name: "username", State: path.Root("id"), Description: "Username of the LDAP User."},
+}
+
+func (*ldapUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = ldapUserIdentity.Schema()
+}
+
 func (r *ldapUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan ldapUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -97,6 +106,7 @@ func (r *ldapUserResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(ldapUserIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created LDAP User", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"username": plan.Username.ValueString(),
@@ -104,6 +114,7 @@ func (r *ldapUserResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *ldapUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(ldapUserIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state ldapUserResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -122,6 +133,9 @@ func (r *ldapUserResource) Read(ctx context.Context, req resource.ReadRequest, r
 			return user.Username == username
 		},
 	)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": username}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read LDAP user",
@@ -188,7 +202,7 @@ func (*ldapUserResource) ImportState(ctx context.Context, req resource.ImportSta
 	tflog.Debug(ctx, "Importing LDAP User", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	ldapUserIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+)
+
+// Streams a list result for each item, up to the requested limit, for `terraform query`.
+// Each result has the identity from the 'id' of the item. When the resource is requested, state is populated by Read
+// of the managed resource, from state holding only 'id', as after import, so that generated config matches import.
+func streamListResults[T any](
+	ctx context.Context, req list.ListRequest, stream *list.ListResultsStream,
+	managed resource.Resource, identity resourceIdentity,
+	items []T, describe func(T) (id string, displayName string),
+) {
+	stream.Results = func(push func(list.ListResult) bool) {
+		for index, item := range items {
+			if req.Limit > 0 && int64(index) >= req.Limit {
+				return
+			}
+			id, displayName := describe(item)
+			if !push(listResult(ctx, req, managed, identity, id, displayName)) {
+				return
+			}
+		}
+	}
+}
+
+func listResult(
+	ctx context.Context, req list.ListRequest, managed resource.Resource, identity resourceIdentity, id, displayName string,
+) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+	state := tfsdk.State{Schema: req.ResourceSchema, Raw: result.Resource.Raw}
+	result.Diagnostics.Append(state.SetAttribute(ctx, path.Root("id"), id)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	result.Diagnostics.Append(identity.Set(ctx, state, result.Identity)...)
+	if result.Diagnostics.HasError() || !req.IncludeResource {
+		return result
+	}
+
+	readReq := resource.ReadRequest{State: state, Identity: result.Identity}
+	readResp := resource.ReadResponse{State: state, Identity: result.Identity}
+	managed.Read(ctx, readReq, &readResp)
+	result.Diagnostics.Append(readResp.Diagnostics...)
+	result.Resource = &tfsdk.Resource{Schema: readResp.State.Schema, Raw: readResp.State.Raw}
+	return result
+}
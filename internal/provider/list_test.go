@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestListResourceSchemas(t *testing.T) {
+	ctx := t.Context()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	requireNoError(t, err)
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	requireNoError(t, err)
+	if len(schemas.Diagnostics) > 0 {
+		t.Fatalf("Expected no diagnostics, received %v", schemas.Diagnostics[0])
+	}
+	for _, typeName := range []string{"project", "team", "policy", "notification_rule", "repository", "user"} {
+		if _, ok := schemas.ListResourceSchemas["dependencytrack_"+typeName]; !ok {
+			t.Errorf("Expected list resource schema for dependencytrack_%s", typeName)
+		}
+	}
+}
+
+func TestStreamListResults(t *testing.T) {
+	ctx := t.Context()
+	managed := &teamResource{}
+	schemaResp := resource.SchemaResponse{}
+	managed.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	req := list.ListRequest{
+		Limit:                  2,
+		IncludeResource:        false,
+		ResourceSchema:         schemaResp.Schema,
+		ResourceIdentitySchema: teamIdentity.Schema(),
+	}
+	items := []string{"c82d6f01-a7a4-41d6-9b03-4f06497f575b", "d82d6f01-a7a4-41d6-9b03-4f06497f575b", "e82d6f01-a7a4-41d6-9b03-4f06497f575b"}
+	stream := list.ListResultsStream{}
+	streamListResults(ctx, req, &stream, managed, teamIdentity, items, func(item string) (string, string) {
+		return item, "Team " + item[:1]
+	})
+
+	results := slices.Collect(stream.Results)
+	requireEqual(t, len(results), 2)
+	for index, result := range results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("Expected no error diagnostics, received %v", result.Diagnostics)
+		}
+		requireEqual(t, result.DisplayName, "Team "+items[index][:1])
+		var id types.String
+		if diags := result.Identity.GetAttribute(ctx, path.Root("id"), &id); diags.HasError() {
+			t.Fatalf("Expected no error diagnostics, received %v", diags)
+		}
+		requireEqual(t, id.ValueString(), items[index])
+		requireEqual(t, result.Resource.Raw.IsNull(), true)
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Returns the schema of a resource, to decode its state as the source of a `moved` block.
+func sourceSchema(ctx context.Context, source resource.Resource) *schema.Schema {
+	resp := resource.SchemaResponse{}
+	source.Schema(ctx, resource.SchemaRequest{}, &resp)
+	return &resp.Schema
+}
+
+// Reports whether the source of a `moved` block is typeName of this provider, with decoded state.
+// When false without error diagnostics, the next StateMover is tried.
+func isMoveFrom(req resource.MoveStateRequest, resp *resource.MoveStateResponse, typeName string) bool {
+	if req.SourceTypeName != typeName || !strings.HasSuffix(req.SourceProviderAddress, "/dependencytrack") {
+		return false
+	}
+	if req.SourceState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to Move Resource State",
+			"Within MoveState, unable to decode state of source resource: "+typeName,
+		)
+		return false
+	}
+	return true
+}
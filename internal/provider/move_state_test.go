@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMoveState(t *testing.T) {
+	ctx := t.Context()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	requireNoError(t, err)
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	requireNoError(t, err)
+	move := func(sourceTypeName, targetTypeName, sourceState string) *tfprotov6.MoveResourceStateResponse {
+		resp, moveErr := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+			SourceProviderAddress: "registry.terraform.io/solarfactories/dependencytrack",
+			SourceTypeName:        sourceTypeName,
+			SourceSchemaVersion:   0,
+			SourceState:           &tfprotov6.RawState{JSON: []byte(sourceState)},
+			TargetTypeName:        targetTypeName,
+		})
+		requireNoError(t, moveErr)
+		return resp
+	}
+
+	resp := move("dependencytrack_config_property", "dependencytrack_config_properties", `{
+		"id": "vuln-source/nvd.enabled",
+		"group": "vuln-source",
+		"name": "nvd.enabled",
+		"value": "true",
+		"type": "BOOLEAN",
+		"description": "Enable NVD"
+	}`)
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Expected no diagnostics, received %v", resp.Diagnostics[0])
+	}
+	target, err := resp.TargetState.Unmarshal(schemas.ResourceSchemas["dependencytrack_config_properties"].ValueType())
+	requireNoError(t, err)
+	var attributes map[string]tftypes.Value
+	requireNoError(t, target.As(&attributes))
+	var properties []tftypes.Value
+	requireNoError(t, attributes["properties"].As(&properties))
+	requireEqual(t, len(properties), 1)
+	var property map[string]tftypes.Value
+	requireNoError(t, properties[0].As(&property))
+	var name string
+	requireNoError(t, property["name"].As(&name))
+	requireEqual(t, name, "nvd.enabled")
+
+	resp = move("dependencytrack_tag_policies", "dependencytrack_policy_tag", `{
+		"id": "tag",
+		"tag": "tag",
+		"policies": ["c82d6f01-a7a4-41d6-9b03-4f06497f575b", "d82d6f01-a7a4-41d6-9b03-4f06497f575b"]
+	}`)
+	requireEqual(t, len(resp.Diagnostics), 1)
+	requireEqual(t, resp.Diagnostics[0].Detail, "Within MoveState, expected a single policy for tag 'tag', received 2.")
+
+	resp = move("dependencytrack_team", "dependencytrack_team_permissions", `{"id": "c82d6f01-a7a4-41d6-9b03-4f06497f575b"}`)
+	requireEqual(t, len(resp.Diagnostics), 1)
+	requireEqual(t, resp.Diagnostics[0].Summary, "Unable to Move Resource State")
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &normalizeSPDXExpressionFunction{}
+)
+
+type (
+	normalizeSPDXExpressionFunction struct{}
+)
+
+func NewNormalizeSPDXExpressionFunction() function.Function {
+	return &normalizeSPDXExpressionFunction{}
+}
+
+func (*normalizeSPDXExpressionFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_spdx_expression"
+}
+
+func (*normalizeSPDXExpressionFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validate and normalise an SPDX license expression.",
+		Description: "Validate the syntax of an SPDX license expression, as specified by SPDX 2.3 Annex D, and normalise it. " +
+			"Operators are upper-cased, whitespace is collapsed, redundant parentheses are removed, " +
+			"and nested operators of the same kind are flattened. " +
+			"The order of operands, and the case of license identifiers, are retained. " +
+			"License identifiers are not checked against the SPDX license list.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "expression",
+				Description: "SPDX license expression, such as '(MIT or Apache-2.0) and GPL-2.0-only with Classpath-exception-2.0'.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (*normalizeSPDXExpressionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression string
+	resp.Error = req.Arguments.Get(ctx, &expression)
+	if resp.Error != nil {
+		return
+	}
+	normalized, err := normalizeSPDXExpression(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid SPDX license expression '"+expression+"': "+err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, normalized)
+}
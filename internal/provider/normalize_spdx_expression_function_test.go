@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccNormalizeSPDXExpressionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::normalize_spdx_expression("((mit or Apache-2.0)) and GPL-2.0-only with Classpath-exception-2.0")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("(mit OR Apache-2.0) AND GPL-2.0-only WITH Classpath-exception-2.0")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::normalize_spdx_expression("MIT OR")
+}
+`,
+				ExpectError: regexp.MustCompile(`expected license at end of expression`),
+			},
+		},
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ var (
 	_ resource.Resource                = &notificationPublisherResource{}
 	_ resource.ResourceWithConfigure   = &notificationPublisherResource{}
 	_ resource.ResourceWithImportState = &notificationPublisherResource{}
+	_ resource.ResourceWithIdentity    = &notificationPublisherResource{}
 )
 
 type (
@@ -86,6 +88,12 @@ func (*notificationPublisherResource) Schema(_ context.Context, _ resource.Schem
 	}
 }
 
+var notificationPublisherIdentity = uuidIdentity("UUID of the Notification Publisher.")
+
+func (*notificationPublisherResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = notificationPublisherIdentity.Schema()
+}
+
 func (r *notificationPublisherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan notificationPublisherResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -134,6 +142,7 @@ func (r *notificationPublisherResource) Create(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationPublisherIdentity.Set(ctx, resp.State, resp.Identity)...)
 
 	tflog.Debug(ctx, "Created Notification Publisher", map[string]any{
 		"id":                 newState.ID.ValueString(),
@@ -147,6 +156,7 @@ func (r *notificationPublisherResource) Create(ctx context.Context, req resource
 }
 
 func (r *notificationPublisherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(notificationPublisherIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state notificationPublisherResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -182,6 +192,9 @@ func (r *notificationPublisherResource) Read(ctx context.Context, req resource.R
 	publisher, err := Find(publishers, func(pub dtrack.NotificationPublisher) bool {
 		return pub.UUID.String() == id.String()
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read Notification Publisher",
@@ -277,6 +290,7 @@ func (r *notificationPublisherResource) Update(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationPublisherIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Notification Publisher", map[string]any{
 		"id":                 state.ID.ValueString(),
 		"name":               state.Name.ValueString(),
@@ -333,16 +347,35 @@ func (r *notificationPublisherResource) Delete(ctx context.Context, req resource
 	})
 }
 
-func (*notificationPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *notificationPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := notificationPublisherIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing Notification Publisher", map[string]any{
-		"id": req.ID,
+		"id": importID,
+	})
+	id, err := ResolveImportID(importID, "<Name>", func(key string) ([]uuid.UUID, error) {
+		publishers, err := r.client.Notification.GetAllPublishers(ctx)
+		return uuidsByName(publishers, key,
+			func(publisher dtrack.NotificationPublisher) string { return publisher.Name },
+			func(publisher dtrack.NotificationPublisher) uuid.UUID { return publisher.UUID },
+		), err
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve notification publisher.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported Notification Publisher", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
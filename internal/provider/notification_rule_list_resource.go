@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &notificationRuleListResource{}
+	_ list.ListResourceWithConfigure = &notificationRuleListResource{}
+)
+
+type notificationRuleListResource struct {
+	managed *notificationRuleResource
+}
+
+func NewNotificationRuleListResource() list.ListResource {
+	return &notificationRuleListResource{managed: &notificationRuleResource{}}
+}
+
+func (*notificationRuleListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_rule"
+}
+
+func (*notificationRuleListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Notification Rules, for `terraform query`.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+}
+
+func (r *notificationRuleListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing Notification Rules")
+	rules, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.NotificationRule], error) {
+		return r.managed.client.Notification.GetAllRules(ctx, po, dtrack.SortOptions{}, dtrack.GetAllRulesFilterOptions{})
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Within List, unable to list notification rules.",
+			"Error from: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tflog.Debug(ctx, "Listed Notification Rules", map[string]any{
+		"count": len(rules),
+	})
+	streamListResults(ctx, req, stream, r.managed, notificationRuleIdentity, rules, func(rule dtrack.NotificationRule) (string, string) {
+		return rule.UUID.String(), rule.Name
+	})
+}
+
+func (r *notificationRuleListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.managed.Configure(ctx, req, resp)
+}
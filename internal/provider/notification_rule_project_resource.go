@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &notificationRuleProjectResource{}
 	_ resource.ResourceWithConfigure   = &notificationRuleProjectResource{}
 	_ resource.ResourceWithImportState = &notificationRuleProjectResource{}
+	_ resource.ResourceWithIdentity    = &notificationRuleProjectResource{}
 )
 
 type (
@@ -71,6 +72,15 @@ func (*notificationRuleProjectResource) Schema(_ context.Context, _ resource.Sch
 	}
 }
 
+var notificationRuleProjectIdentity = resourceIdentity{
+	{Name: "rule_id", State: path.Root("rule"), Description: "UUID of the Notification Rule."},
+	{Name: "project_id", State: path.Root("project"), Description: "UUID of the Project."},
+}
+
+func (*notificationRuleProjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = notificationRuleProjectIdentity.Schema()
+}
+
 func (r *notificationRuleProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan notificationRuleProjectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -116,6 +126,7 @@ func (r *notificationRuleProjectResource) Create(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationRuleProjectIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Notification Rule Project Mapping", map[string]any{
 		"id":      newState.ID.ValueString(),
 		"rule":    newState.Rule.ValueString(),
@@ -124,6 +135,7 @@ func (r *notificationRuleProjectResource) Create(ctx context.Context, req resour
 }
 
 func (r *notificationRuleProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(notificationRuleProjectIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state notificationRuleProjectResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -157,6 +169,9 @@ func (r *notificationRuleProjectResource) Read(ctx context.Context, req resource
 			return rule.UUID == ruleID
 		},
 	)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"rule": ruleID.String(), "project": projectID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read Notification Rule Project Mapping",
@@ -167,6 +182,9 @@ func (r *notificationRuleProjectResource) Read(ctx context.Context, req resource
 	project, err := Find(rule.Projects, func(project dtrack.Project) bool {
 		return project.UUID == projectID
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"rule": ruleID.String(), "project": projectID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to locate Notification Rule Project Mapping when reading",
@@ -234,6 +252,7 @@ func (*notificationRuleProjectResource) Update(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationRuleProjectIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Notification Rule Project Mapping", map[string]any{
 		"id":      newState.ID.ValueString(),
 		"rule":    newState.Rule.ValueString(),
@@ -285,11 +304,16 @@ func (r *notificationRuleProjectResource) Delete(ctx context.Context, req resour
 }
 
 func (*notificationRuleProjectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := notificationRuleProjectIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Within Import, unexpected id",
-			"Expected id in format <Rule UUID>/<Project UUID>. Received "+req.ID,
+			"Expected id in format <Rule UUID>/<Project UUID>. Received "+importID,
 		)
 		return
 	}
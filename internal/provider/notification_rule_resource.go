@@ -3,9 +3,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,9 +22,13 @@ import (
 )
 
 var (
-	_ resource.Resource                = &notificationRuleResource{}
-	_ resource.ResourceWithConfigure   = &notificationRuleResource{}
-	_ resource.ResourceWithImportState = &notificationRuleResource{}
+	_ resource.Resource                     = &notificationRuleResource{}
+	_ resource.ResourceWithConfigure        = &notificationRuleResource{}
+	_ resource.ResourceWithImportState      = &notificationRuleResource{}
+	_ resource.ResourceWithConfigValidators = &notificationRuleResource{}
+	_ resource.ResourceWithModifyPlan       = &notificationRuleResource{}
+	_ resource.ResourceWithIdentity         = &notificationRuleResource{}
+	_ resource.ResourceWithUpgradeState     = &notificationRuleResource{}
 )
 
 type (
@@ -40,7 +45,7 @@ type (
 		LogSuccessfulPublish  types.Bool   `tfsdk:"log_successful_publish"`
 		Scope                 types.String `tfsdk:"scope"`
 		NotificationLevel     types.String `tfsdk:"notification_level"`
-		NotifyOn              types.List   `tfsdk:"notify_on"`
+		NotifyOn              types.Set    `tfsdk:"notify_on"`
 		TriggerType           types.String `tfsdk:"trigger_type"`
 		Message               types.String `tfsdk:"message"`
 		ScheduleCron          types.String `tfsdk:"schedule_cron"`
@@ -60,6 +65,7 @@ func (*notificationRuleResource) Metadata(_ context.Context, req resource.Metada
 
 func (*notificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Manages a Notification Rule.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -92,32 +98,33 @@ func (*notificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:    true,
 			},
 			"scope": schema.StringAttribute{
-				Description: "Scope to which this alert applies. Supports \"PORTFOLIO\", and \"SYSTEM\".",
+				Description: "Scope to which this alert applies. Supports " + describeValues(notificationScopeValues) + ".",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("PORTFOLIO"),
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				Validators: []validator.String{stringvalidator.OneOf("PORTFOLIO", "SYSTEM")},
+				Validators: []validator.String{stringvalidator.OneOf(notificationScopeValues...)},
 			},
 			"notification_level": schema.StringAttribute{
-				Description: "Notification Level to set for Alert. Supports \"INFORMATIONAL\", \"WARNING\", \"ERROR\".",
+				Description: "Notification Level to set for Alert. Supports " + describeValues(notificationLevelValues) + ".",
 				Optional:    true,
 				Computed:    true,
 				Default:     stringdefault.StaticString("INFORMATIONAL"),
-				Validators:  []validator.String{stringvalidator.OneOf("INFORMATIONAL", "WARNING", "ERROR")},
+				Validators:  []validator.String{stringvalidator.OneOf(notificationLevelValues...)},
 			},
-			"notify_on": schema.ListAttribute{
-				Description: "Events on which to trigger alert. Only relevant for trigger_type = \"EVENT\".",
+			"notify_on": schema.SetAttribute{
+				Description: "Events on which to trigger alert. Supports " + describeValues(notificationGroupValues) + ".",
 				Optional:    true,
 				Computed:    true,
 				ElementType: types.StringType,
+				Validators:  []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(notificationGroupValues...))},
 			},
 			"trigger_type": schema.StringAttribute{
 				Description:   "Type of trigger for Rule. Supports \"EVENT\" for API 3.2.0+, \"SCHEDULE\" for API 4.13+.",
 				Required:      true,
-				Validators:    []validator.String{stringvalidator.OneOf("EVENT", "SCHEDULE")},
+				Validators:    []validator.String{stringvalidator.OneOf(notificationTriggerTypeValues...)},
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"message": schema.StringAttribute{
@@ -126,12 +133,12 @@ func (*notificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:    true,
 			},
 			"schedule_cron": schema.StringAttribute{
-				Description: "CRON expression for schedule.",
+				Description: "CRON expression for schedule. Requires trigger_type \"SCHEDULE\".",
 				Optional:    true,
 				Computed:    true,
 			},
 			"schedule_skip_unchanged": schema.BoolAttribute{
-				Description: "Skip sending alert if there is no change.",
+				Description: "Skip sending alert if there is no change. Requires trigger_type \"SCHEDULE\".",
 				Optional:    true,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
@@ -150,6 +157,26 @@ func (*notificationRuleResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (*notificationRuleResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		attributeRequiresValue(path.Root("schedule_cron"), path.Root("trigger_type"), string(dtrack.NotificationRuleTriggerTypeSchedule)),
+		attributeRequiresValue(path.Root("schedule_skip_unchanged"), path.Root("trigger_type"), string(dtrack.NotificationRuleTriggerTypeSchedule)),
+	}
+}
+
+var notificationRuleIdentity = uuidIdentity("UUID of the Notification Rule.")
+
+func (*notificationRuleResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = notificationRuleIdentity.Schema()
+}
+
+func (*notificationRuleResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held `notify_on` as a list.
+		0: {StateUpgrader: upgradeListsToSets},
+	}
+}
+
 func (r *notificationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan notificationRuleResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -188,7 +215,7 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 			UUID: publisherID,
 		},
 	}
-	if hasNotificationChildrenFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationChildren) {
 		ruleReq.NotifyChildren = plan.NotifyChildren.ValueBool()
 	}
 
@@ -257,11 +284,8 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	newNotifyOnStrings := Map(ruleRes.NotifyOn, func(notify dtrack.NotificationRuleNotifyOn) string { return string(notify) })
-	if SliceUnorderedEqual(notifyOn, newNotifyOnStrings, strings.Compare) {
-		newNotifyOnStrings = notifyOn
-	}
 
-	newNotifyOn, diags := types.ListValue(types.StringType, Map(newNotifyOnStrings, func(notify string) attr.Value { return types.StringValue(notify) }))
+	newNotifyOn, diags := types.SetValue(types.StringType, Map(newNotifyOnStrings, func(notify string) attr.Value { return types.StringValue(notify) }))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -283,12 +307,12 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 		PublisherConfig:       types.StringValue(ruleRes.PublisherConfig),
 		PublisherID:           types.StringValue(ruleRes.Publisher.UUID.String()),
 	}
-	if hasNotificationChildrenFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationChildren) {
 		newState.NotifyChildren = types.BoolValue(ruleRes.NotifyChildren)
 	} else {
 		newState.NotifyChildren = plan.NotifyChildren
 	}
-	if hasNotificationTriggerTypeFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationScheduled) {
 		newState.TriggerType = types.StringValue(string(ruleRes.TriggerType))
 	} else {
 		newState.TriggerType = types.StringValue(string(ruleReq.TriggerType))
@@ -299,6 +323,7 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationRuleIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Notification Rule", map[string]any{
 		"id":                      newState.ID,
 		"name":                    newState.Name,
@@ -318,6 +343,7 @@ func (r *notificationRuleResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(notificationRuleIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state notificationRuleResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -349,15 +375,6 @@ func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		resp.Diagnostics.Append(diag)
 		return
 	}
-	notifyOn, err := GetStringList(ctx, &resp.Diagnostics, state.NotifyOn)
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("notify_on"),
-			"Unable to Read Notification Rule as strings",
-			"Error with notify_on, in original error: "+err.Error(),
-		)
-	}
-
 	filter := dtrack.GetAllRulesFilterOptions{}
 	if !state.TriggerType.IsUnknown() && !state.TriggerType.IsNull() {
 		filter.TriggerType = dtrack.NotificationRuleTriggerType(state.TriggerType.ValueString())
@@ -371,6 +388,9 @@ func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 			return rule.UUID.String() == id.String()
 		},
 	)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read Notification Rule",
@@ -379,10 +399,7 @@ func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 	newNotifyOnStrings := Map(rule.NotifyOn, func(notify dtrack.NotificationRuleNotifyOn) string { return string(notify) })
-	if SliceUnorderedEqual(notifyOn, newNotifyOnStrings, strings.Compare) {
-		newNotifyOnStrings = notifyOn
-	}
-	newNotifyOn, diags := types.ListValue(types.StringType, Map(newNotifyOnStrings, func(notify string) attr.Value {
+	newNotifyOn, diags := types.SetValue(types.StringType, Map(newNotifyOnStrings, func(notify string) attr.Value {
 		return types.StringValue(notify)
 	}))
 	resp.Diagnostics.Append(diags...)
@@ -406,12 +423,12 @@ func (r *notificationRuleResource) Read(ctx context.Context, req resource.ReadRe
 		PublisherConfig:       types.StringValue(rule.PublisherConfig),
 		PublisherID:           types.StringValue(rule.Publisher.UUID.String()),
 	}
-	if hasNotificationChildrenFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationChildren) {
 		newState.NotifyChildren = types.BoolValue(rule.NotifyChildren)
 	} else {
 		newState.NotifyChildren = state.NotifyChildren
 	}
-	if hasNotificationTriggerTypeFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationScheduled) {
 		newState.TriggerType = types.StringValue(string(rule.TriggerType))
 	} else {
 		newState.TriggerType = types.StringValue("EVENT")
@@ -486,7 +503,7 @@ func (r *notificationRuleResource) Update(ctx context.Context, req resource.Upda
 			UUID: publisherID,
 		},
 	}
-	if hasNotificationChildrenFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationChildren) {
 		ruleReq.NotifyChildren = plan.NotifyChildren.ValueBool()
 	}
 
@@ -519,10 +536,7 @@ func (r *notificationRuleResource) Update(ctx context.Context, req resource.Upda
 	newNotifyOnStrings := Map(ruleRes.NotifyOn, func(notify dtrack.NotificationRuleNotifyOn) string {
 		return string(notify)
 	})
-	if SliceUnorderedEqual(notifyOn, newNotifyOnStrings, strings.Compare) {
-		newNotifyOnStrings = notifyOn
-	}
-	newNotifyOn, diags := types.ListValue(types.StringType, Map(newNotifyOnStrings, func(notify string) attr.Value {
+	newNotifyOn, diags := types.SetValue(types.StringType, Map(newNotifyOnStrings, func(notify string) attr.Value {
 		return types.StringValue(notify)
 	}))
 	resp.Diagnostics.Append(diags...)
@@ -547,12 +561,12 @@ func (r *notificationRuleResource) Update(ctx context.Context, req resource.Upda
 		PublisherConfig:       types.StringValue(ruleRes.PublisherConfig),
 		PublisherID:           types.StringValue(ruleRes.Publisher.UUID.String()),
 	}
-	if hasNotificationChildrenFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationChildren) {
 		newState.NotifyChildren = types.BoolValue(ruleRes.NotifyChildren)
 	} else {
 		newState.NotifyChildren = plan.NotifyChildren
 	}
-	if hasNotificationTriggerTypeFeature(*r.semver) {
+	if r.semver.Supports(CapabilityNotificationScheduled) {
 		newState.TriggerType = types.StringValue(string(ruleRes.TriggerType))
 	} else {
 		newState.TriggerType = types.StringValue(string(ruleReq.TriggerType))
@@ -564,6 +578,7 @@ func (r *notificationRuleResource) Update(ctx context.Context, req resource.Upda
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationRuleIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Notification Rule", map[string]any{
 		"id":                      newState.ID,
 		"name":                    newState.Name,
@@ -648,19 +663,57 @@ func (r *notificationRuleResource) Delete(ctx context.Context, req resource.Dele
 	})
 }
 
-func (*notificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *notificationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := notificationRuleIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing Notification Rule", map[string]any{
-		"id": req.ID,
+		"id": importID,
+	})
+	id, err := ResolveImportID(importID, "<Name>", func(key string) ([]uuid.UUID, error) {
+		rules, err := FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.NotificationRule], error) {
+			return r.client.Notification.GetAllRules(ctx, po, dtrack.SortOptions{}, dtrack.GetAllRulesFilterOptions{})
+		}, func(rule dtrack.NotificationRule) bool { return rule.Name == key })
+		return Map(rules, func(rule dtrack.NotificationRule) uuid.UUID { return rule.UUID }), err
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve notification rule.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported Notification Rule", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
+func (r *notificationRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed.
+		return
+	}
+	var notifyChildren types.Bool
+	var triggerType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("notify_children"), &notifyChildren)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("trigger_type"), &triggerType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !notifyChildren.IsNull() {
+		requireCapability(r.semver, CapabilityNotificationChildren, path.Root("notify_children"), &resp.Diagnostics)
+	}
+	if triggerType.ValueString() == "SCHEDULE" {
+		requireCapability(r.semver, CapabilityNotificationScheduled, path.Root("trigger_type"), &resp.Diagnostics)
+	}
+}
+
 func (r *notificationRuleResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -677,11 +730,3 @@ func (r *notificationRuleResource) Configure(_ context.Context, req resource.Con
 	r.client = clientInfoData.client
 	r.semver = clientInfoData.semver
 }
-
-func hasNotificationChildrenFeature(semver Semver) bool {
-	return (semver.Major == 4 && semver.Minor >= 12) || (semver.Major >= 5)
-}
-
-func hasNotificationTriggerTypeFeature(semver Semver) bool {
-	return (semver.Major == 4 && semver.Minor >= 13) || (semver.Major >= 5)
-}
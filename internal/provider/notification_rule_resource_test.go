@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -81,9 +82,9 @@ resource "dependencytrack_notification_rule" "test" {
 					resource.TestCheckResourceAttr("dependencytrack_notification_rule.test", "scope", "PORTFOLIO"),
 					resource.TestCheckResourceAttr("dependencytrack_notification_rule.test", "notification_level", "INFORMATIONAL"),
 					resource.TestCheckResourceAttr("dependencytrack_notification_rule.test", "notify_on.#", "3"),
-					resource.TestCheckResourceAttr("dependencytrack_notification_rule.test", "notify_on.0", "NEW_VULNERABILITY"),
-					resource.TestCheckResourceAttr("dependencytrack_notification_rule.test", "notify_on.1", "PROJECT_CREATED"),
-					resource.TestCheckResourceAttr("dependencytrack_notification_rule.test", "notify_on.2", "BOM_PROCESSED"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_notification_rule.test", "notify_on.*", "NEW_VULNERABILITY"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_notification_rule.test", "notify_on.*", "PROJECT_CREATED"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_notification_rule.test", "notify_on.*", "BOM_PROCESSED"),
 				),
 			},
 		},
@@ -171,3 +172,34 @@ resource "dependencytrack_notification_rule" "test" {
 		},
 	})
 }
+
+func TestAccNotificationRuleResourceValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_notification_rule" "test" {
+	name = "Test_Rule_Name_Validation"
+	trigger_type = "EVENT"
+	schedule_cron = "0 0 * * 0"
+	publisher_id = "00000000-0000-0000-0000-000000000000"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute 'schedule_cron' requires 'trigger_type' to be "SCHEDULE"`),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_notification_rule" "test" {
+	name = "Test_Rule_Name_Validation"
+	notification_level = "DEBUG"
+	publisher_id = "00000000-0000-0000-0000-000000000000"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute notification_level value must be one of`),
+			},
+		},
+	})
+}
@@ -19,6 +19,7 @@ var (
 	_ resource.Resource                = &notificationRuleTeamResource{}
 	_ resource.ResourceWithConfigure   = &notificationRuleTeamResource{}
 	_ resource.ResourceWithImportState = &notificationRuleTeamResource{}
+	_ resource.ResourceWithIdentity    = &notificationRuleTeamResource{}
 )
 
 type (
@@ -71,6 +72,15 @@ func (*notificationRuleTeamResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+var notificationRuleTeamIdentity = resourceIdentity{
+	{Name: "rule_id", State: path.Root("rule"), Description: "UUID of the Notification Rule."},
+	{Name: "team_id", State: path.Root("team"), Description: "UUID of the Team."},
+}
+
+func (*notificationRuleTeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = notificationRuleTeamIdentity.Schema()
+}
+
 func (r *notificationRuleTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan notificationRuleTeamResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -116,6 +126,7 @@ func (r *notificationRuleTeamResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationRuleTeamIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Notification Rule Team Mapping", map[string]any{
 		"id":   newState.ID.ValueString(),
 		"rule": newState.Rule.ValueString(),
@@ -124,6 +135,7 @@ func (r *notificationRuleTeamResource) Create(ctx context.Context, req resource.
 }
 
 func (r *notificationRuleTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(notificationRuleTeamIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state notificationRuleTeamResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -157,6 +169,9 @@ func (r *notificationRuleTeamResource) Read(ctx context.Context, req resource.Re
 			return rule.UUID == ruleID
 		},
 	)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"rule": ruleID.String(), "team": teamID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read Notification Rule Team Mapping",
@@ -167,6 +182,9 @@ func (r *notificationRuleTeamResource) Read(ctx context.Context, req resource.Re
 	team, err := Find(rule.Teams, func(team dtrack.Team) bool {
 		return team.UUID == teamID
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"rule": ruleID.String(), "team": teamID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to locate Notification Rule Team Mapping when reading",
@@ -234,6 +252,7 @@ func (*notificationRuleTeamResource) Update(ctx context.Context, req resource.Up
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(notificationRuleTeamIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Notification Rule Team Mapping", map[string]any{
 		"id":   newState.ID.ValueString(),
 		"rule": newState.Rule.ValueString(),
@@ -285,11 +304,16 @@ func (r *notificationRuleTeamResource) Delete(ctx context.Context, req resource.
 }
 
 func (*notificationRuleTeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := notificationRuleTeamIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Within Import, unexpected id",
-			"Expected id in format <Rule UUID>/<Team UUID>. Received "+req.ID,
+			"Expected id in format <Rule UUID>/<Team UUID>. Received "+importID,
 		)
 		return
 	}
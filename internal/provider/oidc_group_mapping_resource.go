@@ -18,6 +18,7 @@ var (
 	_ resource.Resource                = &oidcGroupMappingResource{}
 	_ resource.ResourceWithConfigure   = &oidcGroupMappingResource{}
 	_ resource.ResourceWithImportState = &oidcGroupMappingResource{}
+	_ resource.ResourceWithIdentity    = &oidcGroupMappingResource{}
 )
 
 type (
@@ -70,6 +71,12 @@ func (*oidcGroupMappingResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+var oidcGroupMappingIdentity = uuidIdentity("UUID of the OIDC Group Mapping.")
+
+func (*oidcGroupMappingResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = oidcGroupMappingIdentity.Schema()
+}
+
 func (r *oidcGroupMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan oidcGroupMappingResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -118,6 +125,7 @@ func (r *oidcGroupMappingResource) Create(ctx context.Context, req resource.Crea
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(oidcGroupMappingIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created OIDC Group Mapping", map[string]any{
 		"id":    plan.ID.ValueString(),
 		"group": plan.Group.ValueString(),
@@ -126,6 +134,7 @@ func (r *oidcGroupMappingResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *oidcGroupMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(oidcGroupMappingIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state oidcGroupMappingResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -149,6 +158,9 @@ func (r *oidcGroupMappingResource) Read(ctx context.Context, req resource.ReadRe
 	mappingInfo, err := FindPagedOidcMapping(id, func(po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
 		return r.client.Team.GetAll(ctx, po)
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get group team mapping within Read",
@@ -225,6 +237,7 @@ func (r *oidcGroupMappingResource) Update(ctx context.Context, req resource.Upda
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(oidcGroupMappingIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated OIDC Group Mapping", map[string]any{
 		"id":    plan.ID.ValueString(),
 		"team":  plan.Team.ValueString(),
@@ -273,7 +286,7 @@ func (*oidcGroupMappingResource) ImportState(ctx context.Context, req resource.I
 	tflog.Debug(ctx, "Importing OIDC Group Mapping", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	oidcGroupMappingIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ var (
 	_ resource.Resource                = &oidcGroupResource{}
 	_ resource.ResourceWithConfigure   = &oidcGroupResource{}
 	_ resource.ResourceWithImportState = &oidcGroupResource{}
+	_ resource.ResourceWithIdentity    = &oidcGroupResource{}
 )
 
 type (
@@ -59,6 +61,12 @@ func (*oidcGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+var oidcGroupIdentity = uuidIdentity("UUID of the OIDC Group.")
+
+func (*oidcGroupResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = oidcGroupIdentity.Schema()
+}
+
 func (r *oidcGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan oidcGroupResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -88,6 +96,7 @@ func (r *oidcGroupResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(oidcGroupIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created OIDC Group", map[string]any{
 		"id":   plan.ID.ValueString(),
 		"name": plan.Name.ValueString(),
@@ -95,6 +104,7 @@ func (r *oidcGroupResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *oidcGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(oidcGroupIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state oidcGroupResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -122,6 +132,9 @@ func (r *oidcGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 	oidcGroup, err := Find(oidcGroups, func(group dtrack.OIDCGroup) bool { return group.UUID == id })
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to locate updated oidc group",
@@ -193,6 +206,7 @@ func (r *oidcGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(oidcGroupIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated OIDC Group", map[string]any{
 		"id":   plan.ID.ValueString(),
 		"name": plan.Name.ValueString(),
@@ -234,16 +248,35 @@ func (r *oidcGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 	})
 }
 
-func (*oidcGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *oidcGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := oidcGroupIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing OIDC Group", map[string]any{
-		"id": req.ID,
+		"id": importID,
+	})
+	id, err := ResolveImportID(importID, "<Name>", func(key string) ([]uuid.UUID, error) {
+		groups, err := r.client.OIDC.GetAllGroups(ctx)
+		return uuidsByName(groups, key,
+			func(group dtrack.OIDCGroup) string { return group.Name },
+			func(group dtrack.OIDCGroup) uuid.UUID { return group.UUID },
+		), err
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve oidc group.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported OIDC Group", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
@@ -40,7 +40,8 @@ func (*oidcLoginDataSource) Metadata(_ context.Context, req datasource.MetadataR
 
 func (*oidcLoginDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Authenticate using OIDC Tokens.",
+		Description: "Authenticate using OIDC Tokens. The token is persisted to state, " +
+			"so prefer the `dependencytrack_oidc_login` ephemeral resource with Terraform 1.10+.",
 		Attributes: map[string]schema.Attribute{
 			"token": schema.StringAttribute{
 				Description: "DependencyTrack bearer token.",
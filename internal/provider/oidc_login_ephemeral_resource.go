@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &oidcLoginEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &oidcLoginEphemeralResource{}
+)
+
+type (
+	oidcLoginEphemeralResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	oidcLoginEphemeralResourceModel struct {
+		Token       types.String `tfsdk:"token"`
+		IDToken     types.String `tfsdk:"id_token"`
+		AccessToken types.String `tfsdk:"access_token"`
+		ExpiresAt   types.String `tfsdk:"expires_at"`
+	}
+)
+
+func NewOidcLoginEphemeralResource() ephemeral.EphemeralResource {
+	return &oidcLoginEphemeralResource{}
+}
+
+func (*oidcLoginEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_login"
+}
+
+func (*oidcLoginEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authenticate using OIDC Tokens, without persisting the token to state. Requires Terraform 1.10+.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "DependencyTrack bearer token.",
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"id_token": schema.StringAttribute{
+				Description: "OIDC ID Token from Identity Provider.",
+				Sensitive:   true,
+				Required:    true,
+			},
+			"access_token": schema.StringAttribute{
+				Description: "OIDC Access Token from Identity Provider. Optional if all required fields are present in ID Token.",
+				Sensitive:   true,
+				Optional:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry of the token, in RFC 3339 format. Null if the token does not declare an expiry.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *oidcLoginEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config oidcLoginEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Authenticating using OIDC Tokens")
+	token, err := r.client.OIDC.Login(ctx, dtrack.OIDCTokens{
+		ID:     config.IDToken.ValueString(),
+		Access: config.AccessToken.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Open, unable to Login with OIDC Token",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	result := oidcLoginEphemeralResourceModel{
+		Token:       types.StringValue(token),
+		IDToken:     config.IDToken,
+		AccessToken: config.AccessToken,
+		ExpiresAt:   types.StringNull(),
+	}
+	if expiry := jwtExpiry(token); !expiry.IsZero() {
+		result.ExpiresAt = types.StringValue(expiry.Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Authenticated using OIDC Tokens")
+}
+
+func (r *oidcLoginEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
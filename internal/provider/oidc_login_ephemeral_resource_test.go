@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccOidcLoginEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+variable "oidc_id_token" {
+	type = string
+	sensitive = true
+	nullable = false
+}
+ephemeral "dependencytrack_oidc_login" "test" {
+	id_token = var.oidc_id_token
+}
+provider "echo" {
+	data = ephemeral.dependencytrack_oidc_login.test
+}
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.token"),
+				),
+			},
+		},
+	})
+}
@@ -18,6 +18,7 @@ var (
 	_ resource.Resource                = &oidcUserResource{}
 	_ resource.ResourceWithConfigure   = &oidcUserResource{}
 	_ resource.ResourceWithImportState = &oidcUserResource{}
+	_ resource.ResourceWithIdentity    = &oidcUserResource{}
 )
 
 type (
@@ -62,6 +63,14 @@ func (*oidcUserResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+var oidcUserIdentity = resourceIdentity{
+	{Name: "username", State: path.Root("id"), Description: "Username of the OIDC User."},
+}
+
+func (*oidcUserResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = oidcUserIdentity.Schema()
+}
+
 func (r *oidcUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan oidcUserResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -97,6 +106,7 @@ func (r *oidcUserResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(oidcUserIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created OIDC User", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"username": plan.Username.ValueString(),
@@ -104,6 +114,7 @@ func (r *oidcUserResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *oidcUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(oidcUserIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state oidcUserResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -123,6 +134,9 @@ func (r *oidcUserResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 	user, err := Find(users.Items, func(user dtrack.OIDCUser) bool { return user.Username == username })
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": username}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to locate OIDC user",
@@ -188,7 +202,7 @@ func (*oidcUserResource) ImportState(ctx context.Context, req resource.ImportSta
 	tflog.Debug(ctx, "Importing OIDC User", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	oidcUserIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &parseCPEFunction{}
+)
+
+type (
+	parseCPEFunction struct{}
+)
+
+func NewParseCPEFunction() function.Function {
+	return &parseCPEFunction{}
+}
+
+func (*parseCPEFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_cpe"
+}
+
+func (*parseCPEFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a CPE into its attributes.",
+		Description: "Parse a Common Platform Enumeration, either a CPE 2.3 formatted string or a CPE 2.2 URI, into its attributes, " +
+			"as specified by NISTIR 7695. Attribute values are unescaped. " +
+			"Attributes which are absent, or match any value, are '" + CPEAny + "', and those not applicable are '" + CPENotApplicable + "'.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "cpe",
+				Description: "CPE to parse, such as 'cpe:2.3:a:apache:log4j:2.17.0:*:*:*:*:*:*:*' or 'cpe:/a:apache:log4j:2.17.0'.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: cpeAttributeTypes(),
+		},
+	}
+}
+
+func (*parseCPEFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var cpe string
+	resp.Error = req.Arguments.Get(ctx, &cpe)
+	if resp.Error != nil {
+		return
+	}
+	attributes, err := parseCPE(cpe)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid CPE '"+cpe+"': "+err.Error())
+		return
+	}
+	values := make(map[string]attr.Value, len(attributes))
+	for name, value := range attributes {
+		values[name] = types.StringValue(value)
+	}
+	result, diags := types.ObjectValue(cpeAttributeTypes(), values)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func cpeAttributeTypes() map[string]attr.Type {
+	attributeTypes := make(map[string]attr.Type, len(cpeAttributeNames))
+	for _, name := range cpeAttributeNames {
+		attributeTypes[name] = types.StringType
+	}
+	return attributeTypes
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccParseCPEFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::parse_cpe("cpe:2.3:a:apache:log4j:2.17.0:-:*:*:*:*:*:*")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"part":       knownvalue.StringExact("a"),
+						"vendor":     knownvalue.StringExact("apache"),
+						"product":    knownvalue.StringExact("log4j"),
+						"version":    knownvalue.StringExact("2.17.0"),
+						"update":     knownvalue.StringExact("-"),
+						"edition":    knownvalue.StringExact("*"),
+						"language":   knownvalue.StringExact("*"),
+						"sw_edition": knownvalue.StringExact("*"),
+						"target_sw":  knownvalue.StringExact("*"),
+						"target_hw":  knownvalue.StringExact("*"),
+						"other":      knownvalue.StringExact("*"),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::parse_cpe("cpe:2.3:a:apache:log4j")
+}
+`,
+				ExpectError: regexp.MustCompile(`expected 11 attributes after 'cpe:2.3:', received 3`),
+			},
+		},
+	})
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &parsePURLFunction{}
+
+	purlAttributeTypes = map[string]attr.Type{
+		"type":       types.StringType,
+		"namespace":  types.StringType,
+		"name":       types.StringType,
+		"version":    types.StringType,
+		"qualifiers": types.MapType{ElemType: types.StringType},
+		"subpath":    types.StringType,
+	}
+)
+
+type (
+	parsePURLFunction struct{}
+)
+
+func NewParsePURLFunction() function.Function {
+	return &parsePURLFunction{}
+}
+
+func (*parsePURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_purl"
+}
+
+func (*parsePURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse a Package URL into its components.",
+		Description: "Parse a Package URL, as specified by https://github.com/package-url/purl-spec, into its components. " +
+			"Components are percent-decoded, and normalised as the spec defines for known types. " +
+			"Absent 'namespace', 'version' and 'subpath' are null, and absent 'qualifiers' are an empty map.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "purl",
+				Description: "Package URL to parse, such as 'pkg:maven/org.example/artifact@1.0.0?type=jar'.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: purlAttributeTypes,
+		},
+	}
+}
+
+func (*parsePURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var purl string
+	resp.Error = req.Arguments.Get(ctx, &purl)
+	if resp.Error != nil {
+		return
+	}
+	parsed, err := parsePackageURL(purl)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid Package URL '"+purl+"': "+err.Error())
+		return
+	}
+	qualifiers, diags := types.MapValueFrom(ctx, types.StringType, parsed.Qualifiers)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	result, diags := types.ObjectValue(purlAttributeTypes, map[string]attr.Value{
+		"type":       types.StringValue(parsed.Type),
+		"namespace":  stringOrNull(parsed.Namespace),
+		"name":       types.StringValue(parsed.Name),
+		"version":    stringOrNull(parsed.Version),
+		"qualifiers": qualifiers,
+		"subpath":    stringOrNull(parsed.Subpath),
+	})
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
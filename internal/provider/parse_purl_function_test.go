@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccParsePURLFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::parse_purl("pkg:maven/org.example/artifact@1.0.0?type=jar")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.ObjectExact(map[string]knownvalue.Check{
+						"type":       knownvalue.StringExact("maven"),
+						"namespace":  knownvalue.StringExact("org.example"),
+						"name":       knownvalue.StringExact("artifact"),
+						"version":    knownvalue.StringExact("1.0.0"),
+						"qualifiers": knownvalue.MapExact(map[string]knownvalue.Check{"type": knownvalue.StringExact("jar")}),
+						"subpath":    knownvalue.Null(),
+					})),
+				},
+			},
+			{
+				Config: `
+output "test" {
+	value = provider::dependencytrack::parse_purl("maven/org.example/artifact")
+}
+`,
+				ExpectError: regexp.MustCompile(`expected scheme 'pkg:'`),
+			},
+		},
+	})
+}
@@ -0,0 +1,36 @@
+package provider
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/youmark/pkcs8"
+)
+
+// Decrypts a PEM encoded private key, when it is an encrypted PKCS#8 key, returning an unencrypted PEM encoded key.
+func decryptPrivateKeyPEM(keyPEM []byte, password string) ([]byte, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("failed to find any PEM data in key input")
+	}
+	if block.Type != "ENCRYPTED PRIVATE KEY" {
+		if password != "" {
+			return nil, fmt.Errorf("key password was provided, but key is not an encrypted PKCS#8 key. Found PEM type '%s'", block.Type)
+		}
+		return keyPEM, nil
+	}
+	if password == "" {
+		return nil, errors.New("key is an encrypted PKCS#8 key, but no key password was provided")
+	}
+	key, err := pkcs8.ParsePKCS8PrivateKey(block.Bytes, []byte(password))
+	if err != nil {
+		return nil, errors.New("unable to decrypt PKCS#8 key, the key password is likely incorrect, from: " + err.Error())
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.New("unable to encode decrypted PKCS#8 key, from: " + err.Error())
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Headers: nil, Bytes: der}), nil
+}
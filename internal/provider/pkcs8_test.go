@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"crypto/tls"
+	"os"
+	"testing"
+)
+
+func TestDecryptPrivateKeyPEM(t *testing.T) {
+	plain, err := os.ReadFile("testdata/mtls/key.pem")
+	requireNoError(t, err)
+	cert, err := os.ReadFile("testdata/mtls/cert.pem")
+	requireNoError(t, err)
+
+	unchanged, err := decryptPrivateKeyPEM(plain, "")
+	requireNoError(t, err)
+	requireEqual(t, string(unchanged), string(plain))
+
+	// Produced by `openssl pkcs8 -topk8 -v2 <cipher> -v2prf <prf>`, where SHA-1 is the default PRF, so is omitted.
+	for _, name := range []string{
+		"key_encrypted_aes128_sha1.pem",
+		"key_encrypted_aes128_sha256.pem",
+		"key_encrypted_aes256_sha1.pem",
+		"key_encrypted_aes256_sha256.pem",
+		"key_encrypted.pem",
+	} {
+		t.Run(name, func(t *testing.T) {
+			encrypted, err := os.ReadFile("testdata/mtls/" + name)
+			requireNoError(t, err)
+
+			decrypted, err := decryptPrivateKeyPEM(encrypted, "test-password")
+			requireNoError(t, err)
+			requireEqual(t, string(decrypted), string(plain))
+			_, err = tls.X509KeyPair(cert, decrypted)
+			requireNoError(t, err)
+
+			_, err = decryptPrivateKeyPEM(encrypted, "")
+			requireError(t, err, "password")
+			_, err = decryptPrivateKeyPEM(encrypted, "wrong-password")
+			requireError(t, err, "password")
+		})
+	}
+
+	_, err = decryptPrivateKeyPEM([]byte("not a key"), "")
+	requireError(t, err, "PEM")
+	_, err = decryptPrivateKeyPEM(plain, "test-password")
+	requireError(t, err, "not an encrypted PKCS#8 key")
+}
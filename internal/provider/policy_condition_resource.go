@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +20,7 @@ var (
 	_ resource.Resource                = &policyConditionResource{}
 	_ resource.ResourceWithConfigure   = &policyConditionResource{}
 	_ resource.ResourceWithImportState = &policyConditionResource{}
+	_ resource.ResourceWithIdentity    = &policyConditionResource{}
 )
 
 type (
@@ -62,12 +65,14 @@ func (*policyConditionResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"subject": schema.StringAttribute{
-				Description: "Subject of the Policy Condition. See DependencyTrack for allowed values.",
+				Description: "Subject of the Policy Condition. Supports " + describeValues(policyConditionSubjectValues) + ".",
 				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(policyConditionSubjectValues...)},
 			},
 			"operator": schema.StringAttribute{
-				Description: "Operator for the Policy Condition. See DependencyTrack for allowed values.",
+				Description: "Operator for the Policy Condition. Supports " + describeValues(policyConditionOperatorValues) + ".",
 				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(policyConditionOperatorValues...)},
 			},
 			"value": schema.StringAttribute{
 				Description: "Value against which to compare Subject.",
@@ -77,6 +82,12 @@ func (*policyConditionResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+var policyConditionIdentity = uuidIdentity("UUID of the Policy Condition.")
+
+func (*policyConditionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = policyConditionIdentity.Schema()
+}
+
 func (r *policyConditionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan policyConditionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -126,6 +137,7 @@ func (r *policyConditionResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyConditionIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Policy Condition", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"policy":   plan.PolicyID.ValueString(),
@@ -136,6 +148,7 @@ func (r *policyConditionResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *policyConditionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(policyConditionIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state policyConditionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -160,6 +173,9 @@ func (r *policyConditionResource) Read(ctx context.Context, req resource.ReadReq
 	condition, err := FindPagedPolicyCondition(id, func(po dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error) {
 		return r.client.Policy.GetAll(ctx, po)
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to identify policy condition",
@@ -253,6 +269,7 @@ func (r *policyConditionResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyConditionIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Policy Condition", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"policy":   plan.PolicyID.ValueString(),
@@ -309,7 +326,7 @@ func (*policyConditionResource) ImportState(ctx context.Context, req resource.Im
 	tflog.Debug(ctx, "Importing Policy Condition", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	policyConditionIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
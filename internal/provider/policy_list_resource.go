@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &policyListResource{}
+	_ list.ListResourceWithConfigure = &policyListResource{}
+)
+
+type policyListResource struct {
+	managed *policyResource
+}
+
+func NewPolicyListResource() list.ListResource {
+	return &policyListResource{managed: &policyResource{}}
+}
+
+func (*policyListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+func (*policyListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Policies, for `terraform query`.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+}
+
+func (r *policyListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing Policies")
+	policies, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error) {
+		return r.managed.client.Policy.GetAll(ctx, po)
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Within List, unable to list policies.",
+			"Error from: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tflog.Debug(ctx, "Listed Policies", map[string]any{
+		"count": len(policies),
+	})
+	streamListResults(ctx, req, stream, r.managed, policyIdentity, policies, func(policy dtrack.Policy) (string, string) {
+		return policy.UUID.String(), policy.Name
+	})
+}
+
+func (r *policyListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.managed.Configure(ctx, req, resp)
+}
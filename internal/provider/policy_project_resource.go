@@ -17,6 +17,7 @@ import (
 var (
 	_ resource.Resource              = &policyProjectResource{}
 	_ resource.ResourceWithConfigure = &policyProjectResource{}
+	_ resource.ResourceWithIdentity  = &policyProjectResource{}
 )
 
 type (
@@ -61,6 +62,15 @@ func (*policyProjectResource) Schema(_ context.Context, _ resource.SchemaRequest
 	}
 }
 
+var policyProjectIdentity = resourceIdentity{
+	{Name: "policy_id", State: path.Root("policy"), Description: "UUID of the Policy."},
+	{Name: "project_id", State: path.Root("project"), Description: "UUID of the Project."},
+}
+
+func (*policyProjectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = policyProjectIdentity.Schema()
+}
+
 func (r *policyProjectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan policyProjectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -102,6 +112,7 @@ func (r *policyProjectResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyProjectIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Policy Project Mapping", map[string]any{
 		"policy":  plan.PolicyID.ValueString(),
 		"project": plan.ProjectID.ValueString(),
@@ -109,6 +120,7 @@ func (r *policyProjectResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *policyProjectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(policyProjectIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state policyProjectResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -136,6 +148,9 @@ func (r *policyProjectResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	policy, err := r.client.Policy.Get(ctx, policyID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"policy": policyID.String(), "project": projectID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to retrieve policy",
@@ -146,6 +161,9 @@ func (r *policyProjectResource) Read(ctx context.Context, req resource.ReadReque
 	project, err := Find(policy.Projects, func(project dtrack.Project) bool {
 		return project.UUID == projectID
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"policy": policyID.String(), "project": projectID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to locate project-policy mapping",
@@ -208,6 +226,7 @@ func (*policyProjectResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyProjectIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Policy Project Mapping", map[string]any{
 		"policy":  plan.PolicyID.ValueString(),
 		"project": plan.ProjectID.ValueString(),
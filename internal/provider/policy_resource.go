@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +21,7 @@ var (
 	_ resource.Resource                = &policyResource{}
 	_ resource.ResourceWithConfigure   = &policyResource{}
 	_ resource.ResourceWithImportState = &policyResource{}
+	_ resource.ResourceWithIdentity    = &policyResource{}
 )
 
 type (
@@ -58,17 +62,25 @@ func (*policyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Required:    true,
 			},
 			"operator": schema.StringAttribute{
-				Description: "Operator to apply to conditions. See DependencyTrack for allowed values.",
+				Description: "Operator to apply to conditions. Supports " + describeValues(policyOperatorValues) + ".",
 				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(policyOperatorValues...)},
 			},
 			"violation": schema.StringAttribute{
-				Description: "Violation state for when a condition fails. See DependencyTrack for allowed values.",
+				Description: "Violation state for when a condition fails. Supports " + describeValues(policyViolationStateValues) + ".",
 				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(policyViolationStateValues...)},
 			},
 		},
 	}
 }
 
+var policyIdentity = uuidIdentity("UUID of the Policy.")
+
+func (*policyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = policyIdentity.Schema()
+}
+
 func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan policyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -107,6 +119,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Policy", map[string]any{
 		"id":        plan.ID.ValueString(),
 		"name":      plan.Name.ValueString(),
@@ -116,6 +129,7 @@ func (r *policyResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(policyIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state policyResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -138,6 +152,9 @@ func (r *policyResource) Read(ctx context.Context, req resource.ReadRequest, res
 	})
 
 	policy, err := r.client.Policy.Get(ctx, id)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated policy",
@@ -217,6 +234,7 @@ func (r *policyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Policy", map[string]any{
 		"id":        plan.ID.ValueString(),
 		"name":      plan.Name.ValueString(),
@@ -264,16 +282,34 @@ func (r *policyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	})
 }
 
-func (*policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *policyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := policyIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing Policy", map[string]any{
-		"id": req.ID,
+		"id": importID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := ResolveImportID(importID, "<Name>", func(key string) ([]uuid.UUID, error) {
+		policies, err := FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Policy], error) {
+			return r.client.Policy.GetAll(ctx, po)
+		}, func(policy dtrack.Policy) bool { return policy.Name == key })
+		return Map(policies, func(policy dtrack.Policy) uuid.UUID { return policy.UUID }), err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve policy.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported Policy", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
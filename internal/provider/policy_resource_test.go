@@ -32,6 +32,12 @@ resource "dependencytrack_policy" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "dependencytrack_policy.test",
+				ImportState:       true,
+				ImportStateId:     "Test_Policy",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing.
 			{
 				Config: providerConfig + `
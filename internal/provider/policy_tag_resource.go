@@ -17,6 +17,8 @@ import (
 var (
 	_ resource.Resource              = &policyTagResource{}
 	_ resource.ResourceWithConfigure = &policyTagResource{}
+	_ resource.ResourceWithIdentity  = &policyTagResource{}
+	_ resource.ResourceWithMoveState = &policyTagResource{}
 )
 
 type (
@@ -42,7 +44,8 @@ func (*policyTagResource) Metadata(_ context.Context, req resource.MetadataReque
 
 func (*policyTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an application of a Policy to a Tag.",
+		Description: "Manages an application of a Policy to a Tag. " +
+			"Supports `moved` from a `dependencytrack_tag_policies` with a single policy.",
 		Attributes: map[string]schema.Attribute{
 			"policy": schema.StringAttribute{
 				Description: "UUID for the Policy to apply to the Tag.",
@@ -62,6 +65,55 @@ func (*policyTagResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	}
 }
 
+var policyTagIdentity = resourceIdentity{
+	{Name: "policy_id", State: path.Root("policy"), Description: "UUID of the Policy."},
+	{Name: "tag", State: path.Root("tag"), Description: "Name of the Tag."},
+}
+
+func (*policyTagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = policyTagIdentity.Schema()
+}
+
+func (*policyTagResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// From `dependencytrack_tag_policies`, with a single policy.
+			SourceSchema: sourceSchema(ctx, NewTagPoliciesResource()),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, resp, "dependencytrack_tag_policies") {
+					return
+				}
+				var source tagPoliciesResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if len(source.Policies) != 1 {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("policies"),
+						"Unable to Move Resource State",
+						fmt.Sprintf("Within MoveState, expected a single policy for tag '%s', received %d.", source.Tag.ValueString(), len(source.Policies)),
+					)
+					return
+				}
+				target := policyTagResourceModel{
+					PolicyID: source.Policies[0],
+					Tag:      source.Tag,
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(policyTagIdentity.Set(ctx, resp.TargetState, resp.TargetIdentity)...)
+				tflog.Debug(ctx, "Moved Tag Policies into Policy Tag", map[string]any{
+					"policy": target.PolicyID.ValueString(),
+					"tag":    target.Tag.ValueString(),
+				})
+			},
+		},
+	}
+}
+
 func (r *policyTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan policyTagResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -98,6 +150,7 @@ func (r *policyTagResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyTagIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Policy Tag Mapping", map[string]any{
 		"policy": plan.PolicyID.ValueString(),
 		"tag":    plan.Tag.ValueString(),
@@ -105,6 +158,7 @@ func (r *policyTagResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *policyTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(policyTagIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state policyTagResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -126,6 +180,9 @@ func (r *policyTagResource) Read(ctx context.Context, req resource.ReadRequest,
 		"tag":    tagName,
 	})
 	policy, err := r.client.Policy.Get(ctx, policyID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"policy": policyID.String(), "tag": tagName}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to retrieve policy",
@@ -136,6 +193,9 @@ func (r *policyTagResource) Read(ctx context.Context, req resource.ReadRequest,
 	tag, err := Find(policy.Tags, func(tag dtrack.Tag) bool {
 		return tag.Name == tagName
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"policy": policyID.String(), "tag": tagName}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to locate Policy Tag Mapping",
@@ -191,6 +251,7 @@ func (*policyTagResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(policyTagIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Policy Tag Mapping", map[string]any{
 		"policy": plan.PolicyID.ValueString(),
 		"tag":    plan.Tag.ValueString(),
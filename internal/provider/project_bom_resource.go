@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &projectBOMResource{}
+	_ resource.ResourceWithConfigure  = &projectBOMResource{}
+	_ resource.ResourceWithModifyPlan = &projectBOMResource{}
+)
+
+type (
+	projectBOMResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	projectBOMResourceModel struct {
+		Project        types.String `tfsdk:"project"`
+		ProjectName    types.String `tfsdk:"project_name"`
+		ProjectVersion types.String `tfsdk:"project_version"`
+		AutoCreate     types.Bool   `tfsdk:"auto_create"`
+		Path           types.String `tfsdk:"path"`
+		Content        types.String `tfsdk:"content"`
+		ContentSHA256  types.String `tfsdk:"content_sha256"`
+		Token          types.String `tfsdk:"token"`
+		Timeout        types.String `tfsdk:"timeout"`
+	}
+)
+
+func NewProjectBOMResource() resource.Resource {
+	return &projectBOMResource{}
+}
+
+func (*projectBOMResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_bom"
+}
+
+func (*projectBOMResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a CycloneDX BOM to a Project, and waits for DependencyTrack to process it. " +
+			"The BOM is uploaded again whenever its content changes. " +
+			"Destroying this resource does not remove the Project, nor the Components from the BOM.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "UUID for the Project to which to upload the BOM. Exactly one of 'project' or 'project_name' must be set. " +
+					"When 'project_name' is set, this is the UUID of the Project found after processing.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("project_name"))},
+			},
+			"project_name": schema.StringAttribute{
+				Description: "Name of the Project to which to upload the BOM. Requires 'project_version'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				// The Project is looked up by name and version once processed, which does not find Projects without a version.
+				Validators: []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("project_version"))},
+			},
+			"project_version": schema.StringAttribute{
+				Description: "Version of the Project to which to upload the BOM. Requires 'project_name'.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("project_name"))},
+			},
+			"auto_create": schema.BoolAttribute{
+				Description: "Whether to create the Project from 'project_name' and 'project_version', when it does not exist. " +
+					"Requires 'project_name', and the BOM upload and project creation upload permissions. Defaults to false.",
+				Optional:   true,
+				Computed:   true,
+				Default:    booldefault.StaticBool(false),
+				Validators: []validator.Bool{boolvalidator.AlsoRequires(path.MatchRoot("project_name"))},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path to a file containing the BOM, in CycloneDX JSON or XML. Exactly one of 'path' or 'content' must be set.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("content"))},
+			},
+			"content": schema.StringAttribute{
+				Description: "Content of the BOM, in CycloneDX JSON or XML.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA256 hash of the uploaded BOM, as hex. Changes to the content of the BOM, including the file at 'path', cause it to be uploaded again.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Token of the most recent upload, used to check whether it has been processed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "Duration to wait for DependencyTrack to process the BOM, such as '30s' or '10m'. " +
+					"Defaults to '" + DefaultUploadTimeout.String() + "'.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *projectBOMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectBOMResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Project BOM", map[string]any{
+		"project":         plan.Project.ValueString(),
+		"project_name":    plan.ProjectName.ValueString(),
+		"project_version": plan.ProjectVersion.ValueString(),
+	})
+	r.upload(ctx, &plan, LifecycleCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Created Project BOM", map[string]any{
+		"project": plan.Project.ValueString(),
+		"token":   plan.Token.ValueString(),
+	})
+}
+
+func (r *projectBOMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readUpload(ctx, r.client, req, resp, "BOM")
+}
+
+func (r *projectBOMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectBOMResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Project BOM", map[string]any{
+		"project": plan.Project.ValueString(),
+	})
+	// 'token' is kept from state when the BOM is unchanged, such as when only 'path', 'auto_create' or 'timeout' changed.
+	if plan.Token.IsUnknown() {
+		r.upload(ctx, &plan, LifecycleUpdate, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Updated Project BOM", map[string]any{
+		"project": plan.Project.ValueString(),
+		"token":   plan.Token.ValueString(),
+	})
+}
+
+func (*projectBOMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// DependencyTrack has no concept of removing an uploaded BOM, so the resource is only removed from state.
+	deleteUpload(ctx, req, resp, "BOM")
+}
+
+// Uploads the BOM, waits for it to be processed, and updates the computed attributes of plan.
+func (r *projectBOMResource) upload(ctx context.Context, plan *projectBOMResourceModel, lifecycle LifecycleAction, diagnostics *diag.Diagnostics) {
+	content, _, err := readUploadContent(plan.Path, plan.Content)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Within "+string(lifecycle)+", unable to read BOM",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	hash := uploadContentHash(content)
+	if !plan.ContentSHA256.IsUnknown() && plan.ContentSHA256.ValueString() != hash {
+		diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Within "+string(lifecycle)+", BOM changed since plan",
+			fmt.Sprintf("Planned BOM with SHA256 '%s', but found '%s'. Run plan again.", plan.ContentSHA256.ValueString(), hash),
+		)
+		return
+	}
+	timeout := parseDuration(plan.Timeout, DefaultUploadTimeout, path.Root("timeout"), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	uploadReq := dtrack.BOMUploadRequest{
+		ProjectName:    plan.ProjectName.ValueString(),
+		ProjectVersion: plan.ProjectVersion.ValueString(),
+		AutoCreate:     plan.AutoCreate.ValueBool(),
+		BOM:            base64.StdEncoding.EncodeToString([]byte(content)),
+	}
+	if !plan.Project.IsUnknown() && !plan.Project.IsNull() {
+		projectID, diagnostic := TryParseUUID(plan.Project, lifecycle, path.Root("project"))
+		if diagnostic != nil {
+			diagnostics.Append(diagnostic)
+			return
+		}
+		uploadReq.ProjectUUID = &projectID
+	}
+	token, err := r.client.BOM.Upload(ctx, uploadReq)
+	if err != nil {
+		diagnostics.AddError(
+			"Within "+string(lifecycle)+", unable to upload BOM",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	err = waitForUploadProcessing(ctx, r.client, r.semver, string(token), timeout)
+	if err != nil {
+		diagnostics.AddError(
+			"Within "+string(lifecycle)+", BOM was not processed",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+
+	if uploadReq.ProjectUUID == nil {
+		project, err := r.client.Project.Lookup(ctx, plan.ProjectName.ValueString(), plan.ProjectVersion.ValueString())
+		if err != nil {
+			diagnostics.AddError(
+				"Within "+string(lifecycle)+", unable to locate project after BOM upload",
+				"Error from: "+err.Error(),
+			)
+			return
+		}
+		plan.Project = types.StringValue(project.UUID.String())
+	}
+	plan.ContentSHA256 = types.StringValue(hash)
+	plan.Token = types.StringValue(string(token))
+}
+
+func (*projectBOMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyUploadPlan(ctx, req, resp, "BOM", nil, nil)
+}
+
+func (r *projectBOMResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testAccProjectBOM = `{
+	"bomFormat": "CycloneDX",
+	"specVersion": "1.5",
+	"version": 1,
+	"components": [
+		{"type": "library", "name": "log4j-core", "group": "org.apache.logging.log4j", "version": "%s", "purl": "pkg:maven/org.apache.logging.log4j/log4j-core@%s"}
+	]
+}`
+
+func TestAccProjectBOMResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_Project_BOM"
+}
+resource "dependencytrack_project_bom" "test" {
+	project = dependencytrack_project.test.id
+	content = format(<<-EOT
+` + testAccProjectBOM + `
+EOT
+	, "2.17.0", "2.17.0")
+}
+resource "dependencytrack_project_bom" "auto" {
+	project_name = "Test_Project_BOM_Auto"
+	project_version = "1.0"
+	auto_create = true
+	content = format(<<-EOT
+` + testAccProjectBOM + `
+EOT
+	, "2.17.0", "2.17.0")
+	timeout = "2m"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_project_bom.test", "project",
+						"dependencytrack_project.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_bom.test", "content_sha256"),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_bom.test", "token"),
+					resource.TestCheckResourceAttr("dependencytrack_project_bom.test", "auto_create", "false"),
+					//
+					resource.TestCheckResourceAttrSet("dependencytrack_project_bom.auto", "project"),
+					resource.TestCheckResourceAttr("dependencytrack_project_bom.auto", "project_name", "Test_Project_BOM_Auto"),
+					resource.TestCheckResourceAttr("dependencytrack_project_bom.auto", "auto_create", "true"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_Project_BOM"
+}
+resource "dependencytrack_project_bom" "test" {
+	project = dependencytrack_project.test.id
+	content = format(<<-EOT
+` + testAccProjectBOM + `
+EOT
+	, "2.17.1", "2.17.1")
+}
+resource "dependencytrack_project_bom" "auto" {
+	project_name = "Test_Project_BOM_Auto"
+	project_version = "1.0"
+	auto_create = true
+	content = format(<<-EOT
+` + testAccProjectBOM + `
+EOT
+	, "2.17.0", "2.17.0")
+	timeout = "2m"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_project_bom.test", "project",
+						"dependencytrack_project.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_bom.test", "token"),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_bom.auto", "project"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectBOMResourceProjectNameRequiresVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project_bom" "test" {
+	project_name = "Test_Project_BOM_Unversioned"
+	content = "{}"
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute "project_version" must be specified when "project_name" is\s+specified`),
+			},
+		},
+	})
+}
@@ -197,7 +197,7 @@ func (d *projectDataSource) Read(ctx context.Context, req datasource.ReadRequest
 			"description": property.Description,
 		})
 	}
-	if hasProjectIsLatestFeature(*d.semver) {
+	if d.semver.Supports(CapabilityProjectIsLatest) {
 		projectState.IsLatest = types.BoolValue(*project.IsLatest)
 	}
 
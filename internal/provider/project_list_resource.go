@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &projectListResource{}
+	_ list.ListResourceWithConfigure = &projectListResource{}
+)
+
+type (
+	projectListResource struct {
+		managed *projectResource
+	}
+
+	projectListResourceModel struct {
+		Tag        types.String `tfsdk:"tag"`
+		Classifier types.String `tfsdk:"classifier"`
+		Active     types.Bool   `tfsdk:"active"`
+	}
+)
+
+func NewProjectListResource() list.ListResource {
+	return &projectListResource{managed: &projectResource{}}
+}
+
+func (*projectListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project"
+}
+
+func (*projectListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists Projects, optionally filtered, for `terraform query`.",
+		Attributes: map[string]schema.Attribute{
+			"tag": schema.StringAttribute{
+				Description: "Only list Projects with this Tag.",
+				Optional:    true,
+			},
+			"classifier": schema.StringAttribute{
+				Description: "Only list Projects with this Classifier. Supports " + describeValues(classifierValues) + ".",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(classifierValues...)},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Only list Projects which are active, when true, or inactive, when false.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *projectListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectListResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	tag := config.Tag.ValueString()
+	tflog.Debug(ctx, "Listing Projects", map[string]any{
+		"tag":        tag,
+		"classifier": config.Classifier.ValueString(),
+		"active":     config.Active.String(),
+	})
+	projects, err := FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Project], error) {
+		if tag != "" {
+			return r.managed.client.Project.GetAllByTag(ctx, tag, false, false, po)
+		}
+		return r.managed.client.Project.GetAll(ctx, po)
+	}, func(project dtrack.Project) bool {
+		if !config.Classifier.IsNull() && project.Classifier != config.Classifier.ValueString() {
+			return false
+		}
+		return config.Active.IsNull() || project.Active == config.Active.ValueBool()
+	})
+	if err != nil {
+		diags.AddError(
+			"Within List, unable to list projects.",
+			"Error from: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tflog.Debug(ctx, "Listed Projects", map[string]any{
+		"count": len(projects),
+	})
+	streamListResults(ctx, req, stream, r.managed, projectIdentity, projects, func(project dtrack.Project) (string, string) {
+		if project.Version == "" {
+			return project.UUID.String(), project.Name
+		}
+		return project.UUID.String(), project.Name + "@" + project.Version
+	})
+}
+
+func (r *projectListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.managed.Configure(ctx, req, resp)
+}
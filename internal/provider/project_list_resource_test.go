@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccProjectListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_Project_List"
+	version = "1.0"
+	classifier = "LIBRARY"
+	tags = ["test_project_list"]
+}
+resource "dependencytrack_project" "inactive" {
+	name = "Test_Project_List_Inactive"
+	active = false
+	tags = ["test_project_list"]
+}
+`,
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "dependencytrack_project" "tagged" {
+	provider = dependencytrack
+	config {
+		tag = "test_project_list"
+	}
+}
+list "dependencytrack_project" "active" {
+	provider = dependencytrack
+	config {
+		tag = "test_project_list"
+		classifier = "LIBRARY"
+		active = true
+	}
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("dependencytrack_project.tagged", 2),
+					querycheck.ExpectLength("dependencytrack_project.active", 1),
+					querycheck.ExpectResourceDisplayName("dependencytrack_project.active",
+						queryfilter.ByDisplayName(knownvalue.StringExact("Test_Project_List@1.0")),
+						knownvalue.StringExact("Test_Project_List@1.0"),
+					),
+				},
+			},
+		},
+	})
+}
@@ -6,19 +6,24 @@ import (
 	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &projectPropertyResource{}
-	_ resource.ResourceWithConfigure   = &projectPropertyResource{}
-	_ resource.ResourceWithImportState = &projectPropertyResource{}
+	_ resource.Resource                     = &projectPropertyResource{}
+	_ resource.ResourceWithConfigure        = &projectPropertyResource{}
+	_ resource.ResourceWithImportState      = &projectPropertyResource{}
+	_ resource.ResourceWithIdentity         = &projectPropertyResource{}
+	_ resource.ResourceWithConfigValidators = &projectPropertyResource{}
 )
 
 type (
@@ -28,13 +33,15 @@ type (
 	}
 
 	projectPropertyResourceModel struct {
-		ID          types.String `tfsdk:"id"`
-		Project     types.String `tfsdk:"project"`
-		Group       types.String `tfsdk:"group"`
-		Name        types.String `tfsdk:"name"`
-		Value       types.String `tfsdk:"value"`
-		Type        types.String `tfsdk:"type"`
-		Description types.String `tfsdk:"description"`
+		ID             types.String `tfsdk:"id"`
+		Project        types.String `tfsdk:"project"`
+		Group          types.String `tfsdk:"group"`
+		Name           types.String `tfsdk:"name"`
+		Value          types.String `tfsdk:"value"`
+		ValueWO        types.String `tfsdk:"value_wo"`
+		ValueWOVersion types.Int32  `tfsdk:"value_wo_version"`
+		Type           types.String `tfsdk:"type"`
+		Description    types.String `tfsdk:"description"`
 	}
 )
 
@@ -79,15 +86,31 @@ func (*projectPropertyResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 			"value": schema.StringAttribute{
-				Description: "Value of the Project Property.",
-				Required:    true,
+				Description: "Value of the Project Property. Exactly one of `value` or `value_wo` must be set.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("value_wo"))},
+			},
+			"value_wo": schema.StringAttribute{
+				Description: "Value of an `" + PropertyTypeEncryptedString + "` Project Property, which is never stored in state or plan. " +
+					"Sent on every create and update, as DependencyTrack replaces the value, so change `value_wo_version` to update to a new `value_wo`. " +
+					"Requires Terraform 1.11+.",
+				Sensitive:  true,
+				WriteOnly:  true,
+				Optional:   true,
+				Validators: []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("value_wo_version"))},
+			},
+			"value_wo_version": schema.Int32Attribute{
+				Description: "Version of `value_wo`. Change to send the current value of `value_wo` to DependencyTrack.",
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.AlsoRequires(path.MatchRoot("value_wo"))},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the Project Property. See DependencyTrack for valid enum values.",
+				Description: "Type of the Project Property. Supports " + describeValues(propertyTypeValues) + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{stringvalidator.OneOf(propertyTypeValues...)},
 			},
 			"description": schema.StringAttribute{
 				Description: "Description of the Project Property.",
@@ -98,6 +121,22 @@ func (*projectPropertyResource) Schema(_ context.Context, _ resource.SchemaReque
 	}
 }
 
+func (*projectPropertyResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		attributeRequiresValue(path.Root("value_wo"), path.Root("type"), PropertyTypeEncryptedString),
+	}
+}
+
+var projectPropertyIdentity = resourceIdentity{
+	{Name: "project_id", State: path.Root("project"), Description: "UUID of the Project."},
+	{Name: "group", State: path.Root("group"), Description: "Group name of the Project Property."},
+	{Name: "name", State: path.Root("name"), Description: "Name of the Project Property."},
+}
+
+func (*projectPropertyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectPropertyIdentity.Schema()
+}
+
 func (r *projectPropertyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectPropertyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -111,10 +150,17 @@ func (r *projectPropertyResource) Create(ctx context.Context, req resource.Creat
 		resp.Diagnostics.Append(diag)
 		return
 	}
+	value := plan.Value.ValueString()
+	if !plan.ValueWOVersion.IsNull() {
+		value = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "value_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	propertyReq := dtrack.ProjectProperty{
 		Group:       plan.Group.ValueString(),
 		Name:        plan.Name.ValueString(),
-		Value:       plan.Value.ValueString(),
+		Value:       value,
 		Type:        plan.Type.ValueString(),
 		Description: plan.Description.ValueString(),
 	}
@@ -123,7 +169,6 @@ func (r *projectPropertyResource) Create(ctx context.Context, req resource.Creat
 		"project": project.String(),
 		"group":   propertyReq.Group,
 		"name":    propertyReq.Name,
-		"value":   propertyReq.Value,
 		"type":    propertyReq.Type,
 	})
 	propertyRes, err := r.client.ProjectProperty.Create(ctx, project, propertyReq)
@@ -149,6 +194,7 @@ func (r *projectPropertyResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(projectPropertyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created a Project Property", map[string]any{
 		"id":          plan.ID.ValueString(),
 		"project":     plan.Project.ValueString(),
@@ -161,6 +207,7 @@ func (r *projectPropertyResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *projectPropertyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(projectPropertyIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state projectPropertyResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -196,11 +243,15 @@ func (r *projectPropertyResource) Read(ctx context.Context, req resource.ReadReq
 			return true
 		},
 	)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": state.ID.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to locate Project Property.",
 			"Error from: "+err.Error(),
 		)
+		return
 	}
 	propertyState := projectPropertyResourceModel{
 		ID:          types.StringValue(fmt.Sprintf("%s/%s/%s", project.String(), property.Group, property.Name)),
@@ -208,8 +259,11 @@ func (r *projectPropertyResource) Read(ctx context.Context, req resource.ReadReq
 		Group:       types.StringValue(property.Group),
 		Name:        types.StringValue(property.Name),
 		Value:       types.StringValue(property.Value),
+		ValueWO:     types.StringNull(),
 		Type:        types.StringValue(property.Type),
 		Description: types.StringValue(property.Description),
+		// Not returned by DependencyTrack.
+		ValueWOVersion: state.ValueWOVersion,
 	}
 	if property.Type == PropertyTypeEncryptedString {
 		propertyState.Value = state.Value
@@ -242,10 +296,17 @@ func (r *projectPropertyResource) Update(ctx context.Context, req resource.Updat
 		resp.Diagnostics.Append(diag)
 		return
 	}
+	value := plan.Value.ValueString()
+	if !plan.ValueWOVersion.IsNull() {
+		value = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "value_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	propertyReq := dtrack.ProjectProperty{
 		Group:       plan.Group.ValueString(),
 		Name:        plan.Name.ValueString(),
-		Value:       plan.Value.ValueString(),
+		Value:       value,
 		Type:        plan.Type.ValueString(),
 		Description: plan.Description.ValueString(),
 	}
@@ -255,7 +316,6 @@ func (r *projectPropertyResource) Update(ctx context.Context, req resource.Updat
 		"project":     project.String(),
 		"group":       propertyReq.Group,
 		"name":        propertyReq.Name,
-		"value":       propertyReq.Value,
 		"type":        propertyReq.Type,
 		"description": propertyReq.Description,
 	})
@@ -273,8 +333,11 @@ func (r *projectPropertyResource) Update(ctx context.Context, req resource.Updat
 		Group:       types.StringValue(propertyRes.Group),
 		Name:        types.StringValue(propertyRes.Name),
 		Value:       types.StringValue(propertyRes.Value),
+		ValueWO:     types.StringNull(),
 		Type:        types.StringValue(propertyRes.Type),
 		Description: types.StringValue(propertyRes.Description),
+		// Not returned by DependencyTrack.
+		ValueWOVersion: plan.ValueWOVersion,
 	}
 	if propertyRes.Type == PropertyTypeEncryptedString {
 		state.Value = plan.Value
@@ -284,6 +347,7 @@ func (r *projectPropertyResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(projectPropertyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Project Property", map[string]any{
 		"id":          state.ID.ValueString(),
 		"project":     state.Project.ValueString(),
@@ -340,23 +404,31 @@ func (r *projectPropertyResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *projectPropertyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := projectPropertyIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected import id",
-			"Expected id in format <Project UUID>/<Group>/<Name>. Received "+req.ID,
+			"Expected id in format <Project UUID>/<Group>/<Name> or <Project Name>@<Version>/<Group>/<Name>. Received "+importID,
 		)
 		return
 	}
-	project, diag := TryParseUUID(types.StringValue(idParts[0]), LifecycleImport, path.Root("id"))
-	if diag != nil {
-		resp.Diagnostics.Append(diag)
+	project, err := resolveProjectImportID(ctx, r.client, idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve project.",
+			"Error from: "+err.Error(),
+		)
 		return
 	}
 	groupName := idParts[1]
 	propertyName := idParts[2]
 	tflog.Debug(ctx, "Importing Project Property", map[string]any{
-		"id":      req.ID,
+		"id":      importID,
 		"project": project.String(),
 		"group":   groupName,
 		"name":    propertyName,
@@ -381,6 +453,7 @@ func (r *projectPropertyResource) ImportState(ctx context.Context, req resource.
 			"Within Import, unable to locate project property.",
 			"Unexpected error from: "+err.Error(),
 		)
+		return
 	}
 	propertyState := projectPropertyResourceModel{
 		ID:          types.StringValue(fmt.Sprintf("%s/%s/%s", project.String(), property.Group, property.Name)),
@@ -388,8 +461,11 @@ func (r *projectPropertyResource) ImportState(ctx context.Context, req resource.
 		Group:       types.StringValue(property.Group),
 		Name:        types.StringValue(property.Name),
 		Value:       types.StringValue(property.Value),
+		ValueWO:     types.StringNull(),
 		Type:        types.StringValue(property.Type),
 		Description: types.StringValue(property.Description),
+		// Not returned by DependencyTrack.
+		ValueWOVersion: types.Int32Null(),
 	}
 	diags := resp.State.Set(ctx, propertyState)
 	resp.Diagnostics.Append(diags...)
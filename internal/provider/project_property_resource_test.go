@@ -4,6 +4,10 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccProjectPropertyResource(t *testing.T) {
@@ -65,6 +69,12 @@ resource "dependencytrack_project_property" "testencrypted" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "dependencytrack_project_property.test",
+				ImportState:       true,
+				ImportStateId:     "Test_ProjectProperty@/A/B",
+				ImportStateVerify: true,
+			},
 			{
 				ResourceName:            "dependencytrack_project_property.testencrypted",
 				ImportState:             true,
@@ -123,3 +133,101 @@ resource "dependencytrack_project_property" "testencrypted" {
 		},
 	})
 }
+
+func TestAccProjectPropertyResourceIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_ProjectProperty_Identity"
+}
+resource "dependencytrack_project_property" "test" {
+	project = dependencytrack_project.test.id
+	group = "A"
+	name = "B"
+	value = "C"
+	type = "STRING"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("dependencytrack_project_property.test", map[string]knownvalue.Check{
+						"project_id": knownvalue.NotNull(),
+						"group":      knownvalue.StringExact("A"),
+						"name":       knownvalue.StringExact("B"),
+					}),
+					statecheck.ExpectIdentityValueMatchesStateAtPath("dependencytrack_project_property.test",
+						tfjsonpath.New("project_id"), tfjsonpath.New("project")),
+				},
+			},
+			{
+				ResourceName:    "dependencytrack_project_property.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+			{
+				ResourceName:    "dependencytrack_project_property.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithID,
+			},
+		},
+	})
+}
+
+func TestAccProjectPropertyResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_ProjectProperty_WriteOnly"
+}
+resource "dependencytrack_project_property" "test" {
+	project = dependencytrack_project.test.id
+	group = "G-Enc"
+	name = "N-Enc"
+	value_wo = "TEST_ENCRYPTED_VALUE"
+	value_wo_version = 1
+	type = "ENCRYPTEDSTRING"
+	description = "D-Enc"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dependencytrack_project_property.test", "id"),
+					resource.TestCheckNoResourceAttr("dependencytrack_project_property.test", "value"),
+					resource.TestCheckNoResourceAttr("dependencytrack_project_property.test", "value_wo"),
+					resource.TestCheckResourceAttr("dependencytrack_project_property.test", "value_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_ProjectProperty_WriteOnly"
+}
+resource "dependencytrack_project_property" "test" {
+	project = dependencytrack_project.test.id
+	group = "G-Enc"
+	name = "N-Enc"
+	value_wo = "TEST_ENCRYPTED_VALUE_WITH_CHANGE"
+	value_wo_version = 2
+	type = "ENCRYPTEDSTRING"
+	description = "D-Enc"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dependencytrack_project_property.test", "value"),
+					resource.TestCheckResourceAttr("dependencytrack_project_property.test", "value_wo_version", "2"),
+					resource.TestCheckResourceAttr("dependencytrack_project_property.test", "description", "D-Enc"),
+				),
+			},
+		},
+	})
+}
@@ -1,31 +1,39 @@
 package provider
 
 import (
+	"cmp"
 	"context"
 	"fmt"
-	"strings"
+	"slices"
 
 	dtrack "github.com/DependencyTrack/client-go"
-	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource                = &projectResource{}
-	_ resource.ResourceWithConfigure   = &projectResource{}
-	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.Resource                     = &projectResource{}
+	_ resource.ResourceWithConfigure        = &projectResource{}
+	_ resource.ResourceWithImportState      = &projectResource{}
+	_ resource.ResourceWithModifyPlan       = &projectResource{}
+	_ resource.ResourceWithConfigValidators = &projectResource{}
+	_ resource.ResourceWithIdentity         = &projectResource{}
+	_ resource.ResourceWithUpgradeState     = &projectResource{}
+	_ resource.ResourceWithMoveState        = &projectResource{}
 )
 
 type (
 	projectResource struct {
-		client *dtrack.Client
-		semver *Semver
+		client      *dtrack.Client
+		semver      *Semver
+		defaultTags []string
 	}
 
 	projectResourceModel struct {
@@ -40,7 +48,8 @@ type (
 		PURL        types.String                    `tfsdk:"purl"`
 		CPE         types.String                    `tfsdk:"cpe"`
 		SWID        types.String                    `tfsdk:"swid"`
-		Tags        types.List                      `tfsdk:"tags"`
+		Tags        types.Set                       `tfsdk:"tags"`
+		TagsAll     types.Set                       `tfsdk:"tags_all"`
 		Active      types.Bool                      `tfsdk:"active"`
 		IsLatest    types.Bool                      `tfsdk:"is_latest"`
 	}
@@ -61,7 +70,8 @@ func (*projectResource) Metadata(_ context.Context, req resource.MetadataRequest
 
 func (*projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages a Project.",
+		Version:     1,
+		Description: "Manages a Project. Supports `moved` from a `dependencytrack_tag_projects` with a single project.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "UUID for the Project as generated by DependencyTrack.",
@@ -99,9 +109,10 @@ func (*projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 			},
 			"classifier": schema.StringAttribute{
-				Description: "Classifier of the Project. Defaults to APPLICATION. See DependencyTrack for valid options.",
+				Description: "Classifier of the Project. Defaults to \"APPLICATION\". Supports " + describeValues(classifierValues) + ".",
 				Optional:    true,
 				Computed:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(classifierValues...)},
 			},
 			"group": schema.StringAttribute{
 				Description: "Namespace / group / vendor of the Project.",
@@ -123,7 +134,7 @@ func (*projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Optional:    true,
 				Computed:    true,
 			},
-			"tags": schema.ListAttribute{
+			"tags": schema.SetAttribute{
 				Description: "Tags to assign to a project. " +
 					"If unset, retains existing tags on project. " +
 					"If set, and `dependencytrack_tag_projects` is used with any of the tags, it must include this project's `id`.",
@@ -131,13 +142,19 @@ func (*projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    true,
 				ElementType: types.StringType,
 			},
+			"tags_all": schema.SetAttribute{
+				Description: "Tags assigned to the project, including `default_tags` from the provider.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
 			"collection": schema.SingleNestedAttribute{
 				Description: "Project Collection Logic for Aggregate Projects. Available in API 4.13+.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"logic": schema.StringAttribute{
-						Description: "Logic used for collecting sub-projects. See DependencyTrack for valid values.",
+						Description: "Logic used for collecting sub-projects. Supports " + describeValues(collectionLogicValues) + ".",
 						Required:    true,
+						Validators:  []validator.String{stringvalidator.OneOf(collectionLogicValues...)},
 					},
 					"tag": schema.StringAttribute{
 						Description: "Tag used for selecting which projects to collect, when 'logic' is 'AGGREGATE_DIRECT_CHILDREN_WITH_TAG'.",
@@ -149,6 +166,84 @@ func (*projectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 	}
 }
 
+func (*projectResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		attributeRequiresValue(path.Root("collection").AtName("tag"), path.Root("collection").AtName("logic"),
+			string(dtrack.CollectionLogicAggregateDirectChildrenWithTag)),
+	}
+}
+
+var projectIdentity = uuidIdentity("UUID of the Project.")
+
+func (*projectResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectIdentity.Schema()
+}
+
+func (*projectResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held `tags` and `tags_all` as a list.
+		0: {StateUpgrader: upgradeListsToSets},
+	}
+}
+
+func (*projectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// From `dependencytrack_tag_projects`, with a single project. Other attributes are populated by Read.
+			SourceSchema: sourceSchema(ctx, NewTagProjectsResource()),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, resp, "dependencytrack_tag_projects") {
+					return
+				}
+				var source tagProjectsResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				if len(source.Projects) != 1 {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("projects"),
+						"Unable to Move Resource State",
+						fmt.Sprintf("Within MoveState, expected a single project for tag '%s', received %d.", source.Tag.ValueString(), len(source.Projects)),
+					)
+					return
+				}
+				tags, diags := types.SetValueFrom(ctx, types.StringType, []types.String{source.Tag})
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := projectResourceModel{
+					ID:          source.Projects[0],
+					Name:        types.StringNull(),
+					Description: types.StringNull(),
+					Version:     types.StringNull(),
+					Parent:      types.StringNull(),
+					Classifier:  types.StringNull(),
+					Group:       types.StringNull(),
+					PURL:        types.StringNull(),
+					CPE:         types.StringNull(),
+					SWID:        types.StringNull(),
+					Tags:        tags,
+					TagsAll:     types.SetNull(types.StringType),
+					Active:      types.BoolNull(),
+					IsLatest:    types.BoolNull(),
+					Collection:  nil,
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(projectIdentity.Set(ctx, resp.TargetState, resp.TargetIdentity)...)
+				tflog.Debug(ctx, "Moved Tag Projects into Project", map[string]any{
+					"id":   target.ID.ValueString(),
+					"tags": source.Tag.ValueString(),
+				})
+			},
+		},
+	}
+}
+
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan projectResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -180,28 +275,26 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			UUID: parentID,
 		}
 	}
-	if !plan.Tags.IsUnknown() && !plan.Tags.IsNull() {
-		tagStrings, err := GetStringList(ctx, &resp.Diagnostics, plan.Tags)
-		if resp.Diagnostics.HasError() {
-			return
-		}
-		if err != nil {
-			resp.Diagnostics.AddAttributeError(
-				path.Root("tags"),
-				"Within Create, unable to convert `tags` list into slice of string, in project: "+projectReq.Name,
-				"Error from: "+err.Error(),
-			)
-			return
-		}
-		projectReq.Tags = Map(tagStrings, func(item string) dtrack.Tag { return dtrack.Tag{Name: item} })
+	tagStrings, err := GetStringList(ctx, &resp.Diagnostics, plan.Tags)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Within Create, unable to convert `tags` set into slice of string, in project: "+projectReq.Name,
+			"Error from: "+err.Error(),
+		)
+		return
 	}
+	projectReq.Tags = Map(mergeDefaultTags(tagStrings, r.defaultTags), func(item string) dtrack.Tag { return dtrack.Tag{Name: item} })
 	if plan.Active.IsUnknown() {
 		projectReq.Active = true
 	}
 	if plan.Classifier.IsUnknown() {
 		projectReq.Classifier = "APPLICATION"
 	}
-	if hasProjectCollectionFeature(*r.semver) && plan.Collection != nil {
+	if r.semver.Supports(CapabilityProjectCollection) && plan.Collection != nil {
 		collectionLogic := dtrack.CollectionLogic(plan.Collection.Logic.ValueString())
 		projectReq.CollectionLogic = &collectionLogic
 		tagName := plan.Collection.Tag.ValueString()
@@ -211,7 +304,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			projectReq.CollectionTag = nil
 		}
 	}
-	if hasProjectIsLatestFeature(*r.semver) && !plan.IsLatest.IsNull() {
+	if r.semver.Supports(CapabilityProjectIsLatest) && !plan.IsLatest.IsNull() {
 		projectReq.IsLatest = plan.IsLatest.ValueBoolPointer()
 	}
 
@@ -239,16 +332,10 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		)
 		return
 	}
-	resTags := projectRes.Tags
-	if SliceUnorderedEqual(projectReq.Tags, projectRes.Tags, func(req, res dtrack.Tag) int {
-		return strings.Compare(req.Name, res.Name)
-	}) {
-		resTags = projectReq.Tags
-	}
-	tagValueList := Map(resTags, func(tag dtrack.Tag) attr.Value {
-		return types.StringValue(tag.Name)
-	})
-	tagList, diags := types.ListValue(types.StringType, tagValueList)
+	resTagsAll := Map(projectRes.Tags, func(tag dtrack.Tag) string { return tag.Name })
+	tagList, diags := types.SetValueFrom(ctx, types.StringType, withoutDefaultTags(resTagsAll, tagStrings, r.defaultTags))
+	resp.Diagnostics.Append(diags...)
+	tagAllList, diags := types.SetValueFrom(ctx, types.StringType, resTagsAll)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -267,18 +354,19 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 		CPE:         types.StringValue(projectRes.CPE),
 		SWID:        types.StringValue(projectRes.SWIDTagID),
 		Tags:        tagList,
+		TagsAll:     tagAllList,
 		Collection:  nil, // Updated below.
 	}
 	if projectRes.ParentRef != nil {
 		plan.Parent = types.StringValue(projectRes.ParentRef.UUID.String())
-	} else if projectReq.ParentRef != nil && r.semver.Major == 4 && r.semver.Minor < 12 {
+	} else if projectReq.ParentRef != nil && !r.semver.Supports(CapabilityProjectCreateReturnsParent) {
 		// Creates a project with the Parent, but does not return Parent within Create Endpoint.
 		// The parent being set is validated by the Read method, in combination with tests.
 		plan.Parent = types.StringValue(projectReq.ParentRef.UUID.String())
 	} else {
 		plan.Parent = types.StringNull()
 	}
-	if hasProjectCollectionFeature(*r.semver) {
+	if r.semver.Supports(CapabilityProjectCollection) {
 		if projectRes.CollectionLogic == nil || (*projectRes.CollectionLogic == "NONE" && projectReq.CollectionLogic == nil) {
 			plan.Collection = nil
 		} else {
@@ -293,7 +381,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 			}
 		}
 	}
-	if hasProjectIsLatestFeature(*r.semver) {
+	if r.semver.Supports(CapabilityProjectIsLatest) {
 		plan.IsLatest = types.BoolValue(*projectRes.IsLatest)
 	}
 
@@ -302,6 +390,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(projectIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created a Project", map[string]any{
 		"id":               projectRes.UUID.String(),
 		"name":             projectRes.Name,
@@ -322,6 +411,7 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(projectIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state projectResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -352,6 +442,9 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		"tags":        state.Tags.Elements(),
 	})
 	project, err := r.client.Project.Get(ctx, id)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated project",
@@ -368,13 +461,11 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		)
 		return
 	}
-	returnedTags := Map(project.Tags, func(tag dtrack.Tag) string { return tag.Name })
-	newStateTags := returnedTags
-	if SliceUnorderedEqual(stateTags, returnedTags, strings.Compare) {
-		newStateTags = stateTags
-	}
-	tagValueList := Map(newStateTags, func(name string) attr.Value { return types.StringValue(name) })
-	tagList, diags := types.ListValue(types.StringType, tagValueList)
+	newStateTagsAll := Map(project.Tags, func(tag dtrack.Tag) string { return tag.Name })
+	newStateTags := withoutDefaultTags(newStateTagsAll, stateTags, r.defaultTags)
+	tagList, diags := types.SetValueFrom(ctx, types.StringType, newStateTags)
+	resp.Diagnostics.Append(diags...)
+	tagAllList, diags := types.SetValueFrom(ctx, types.StringType, newStateTagsAll)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -394,6 +485,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		CPE:         types.StringValue(project.CPE),
 		SWID:        types.StringValue(project.SWIDTagID),
 		Tags:        tagList,
+		TagsAll:     tagAllList,
 		Collection:  nil, // Updated below.
 	}
 	if project.ParentRef != nil {
@@ -401,7 +493,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	} else {
 		newState.Parent = types.StringNull()
 	}
-	if hasProjectCollectionFeature(*r.semver) {
+	if r.semver.Supports(CapabilityProjectCollection) {
 		if project.CollectionLogic == nil || (*project.CollectionLogic == "NONE" && state.Collection == nil) {
 			newState.Collection = nil
 		} else {
@@ -416,7 +508,7 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 			}
 		}
 	}
-	if hasProjectIsLatestFeature(*r.semver) {
+	if r.semver.Supports(CapabilityProjectIsLatest) {
 		newState.IsLatest = types.BoolValue(*project.IsLatest)
 	}
 
@@ -500,8 +592,8 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	} else {
 		project.ParentRef = nil
 	}
+	var stringList []string
 	if !plan.Tags.IsUnknown() && !plan.Tags.IsNull() {
-		var stringList []string
 		stringList, err = GetStringList(ctx, &resp.Diagnostics, plan.Tags)
 		if resp.Diagnostics.HasError() {
 			return
@@ -509,19 +601,39 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("tags"),
-				"Within Create, unable to convert `tags` list into slice of string, in project: "+project.UUID.String(),
+				"Within Update, unable to convert `tags` set into slice of string, in project: "+project.UUID.String(),
 				"Error from: "+err.Error(),
 			)
 			return
 		}
-		project.Tags = Map(stringList, func(item string) dtrack.Tag { return dtrack.Tag{Name: item} })
+	} else {
+		// Retain existing tags, other than those previously added from `default_tags`.
+		var stateTags, stateTagsAll types.Set
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags"), &stateTags)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tags_all"), &stateTagsAll)...)
+		previousTags, tagsErr := GetStringList(ctx, &resp.Diagnostics, stateTags)
+		previousTagsAll, tagsAllErr := GetStringList(ctx, &resp.Diagnostics, stateTagsAll)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if err = cmp.Or(tagsErr, tagsAllErr); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tags"),
+				"Within Update, unable to load current tags on project: "+project.UUID.String(),
+				"Error from: "+err.Error(),
+			)
+			return
+		}
+		existingTags := Map(project.Tags, func(tag dtrack.Tag) string { return tag.Name })
+		stringList = withoutDefaultTags(existingTags, previousTags, previousTagsAll)
 	}
-	if hasProjectCollectionFeature(*r.semver) && plan.Collection != nil {
+	project.Tags = Map(mergeDefaultTags(stringList, r.defaultTags), func(item string) dtrack.Tag { return dtrack.Tag{Name: item} })
+	if r.semver.Supports(CapabilityProjectCollection) && plan.Collection != nil {
 		collectionLogic := dtrack.CollectionLogic(plan.Collection.Logic.ValueString())
 		project.CollectionLogic = &collectionLogic
 		project.CollectionTag = &dtrack.Tag{Name: plan.Collection.Tag.ValueString()}
 	}
-	if hasProjectIsLatestFeature(*r.semver) && !plan.IsLatest.IsNull() {
+	if r.semver.Supports(CapabilityProjectIsLatest) && !plan.IsLatest.IsNull() {
 		project.IsLatest = plan.IsLatest.ValueBoolPointer()
 	}
 
@@ -552,10 +664,9 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	tagValueList := Map(project.Tags, func(tag dtrack.Tag) attr.Value {
-		return types.StringValue(tag.Name)
-	})
-	tagList, diags := types.ListValue(types.StringType, tagValueList)
+	tagList, diags := types.SetValueFrom(ctx, types.StringType, stringList)
+	resp.Diagnostics.Append(diags...)
+	tagAllList, diags := types.SetValueFrom(ctx, types.StringType, Map(project.Tags, func(tag dtrack.Tag) string { return tag.Name }))
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -576,6 +687,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 		CPE:         types.StringValue(projectRes.CPE),
 		SWID:        types.StringValue(projectRes.SWIDTagID),
 		Tags:        tagList,
+		TagsAll:     tagAllList,
 		Collection:  nil, // Updated below.
 	}
 	if projectRes.ParentRef != nil {
@@ -583,8 +695,10 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	} else {
 		newPlan.Parent = types.StringNull()
 	}
-	if hasProjectCollectionFeature(*r.semver) {
-		if ((r.semver.Major == 4 && *projectRes.CollectionLogic == "NONE") || (r.semver.Major >= 5 && projectRes.CollectionLogic == nil)) && plan.Collection == nil {
+	if r.semver.Supports(CapabilityProjectCollection) {
+		notCollection := projectRes.CollectionLogic == nil ||
+			(!r.semver.Supports(CapabilityProjectCollectionOmitted) && *projectRes.CollectionLogic == "NONE" && plan.Collection == nil)
+		if notCollection {
 			newPlan.Collection = nil
 		} else {
 			newPlan.Collection = &projectResourceModelCollection{
@@ -598,7 +712,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 			}
 		}
 	}
-	if hasProjectIsLatestFeature(*r.semver) {
+	if r.semver.Supports(CapabilityProjectIsLatest) {
 		newPlan.IsLatest = types.BoolValue(*projectRes.IsLatest)
 	}
 
@@ -608,6 +722,7 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(projectIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Project", map[string]any{
 		"id":               projectRes.UUID.String(),
 		"name":             projectRes.Name,
@@ -684,19 +799,73 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 	})
 }
 
-func (*projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := projectIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing Project", map[string]any{
-		"id": req.ID,
+		"id": importID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	id, err := resolveProjectImportID(ctx, r.client, importID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve project.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported Project", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed.
+		return
+	}
+	var isLatest types.Bool
+	var collection types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("is_latest"), &isLatest)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("collection"), &collection)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !isLatest.IsNull() {
+		requireCapability(r.semver, CapabilityProjectIsLatest, path.Root("is_latest"), &resp.Diagnostics)
+	}
+	if !collection.IsNull() {
+		requireCapability(r.semver, CapabilityProjectCollection, path.Root("collection"), &resp.Diagnostics)
+	}
+
+	// Plan `tags_all` as `tags` merged with `default_tags`, so changes to either are shown in the plan.
+	var tags types.Set
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tags.IsUnknown() || r.client == nil {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), types.SetUnknown(types.StringType))...)
+		return
+	}
+	tagStrings, err := GetStringList(ctx, &resp.Diagnostics, tags)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tags"),
+			"Within ModifyPlan, unable to convert `tags` set into slice of string",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), mergeDefaultTags(tagStrings, r.defaultTags))...)
+}
+
 func (r *projectResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -712,12 +881,23 @@ func (r *projectResource) Configure(_ context.Context, req resource.ConfigureReq
 	}
 	r.client = clientInfoData.client
 	r.semver = clientInfoData.semver
+	r.defaultTags = clientInfoData.defaultTags
 }
 
-func hasProjectCollectionFeature(semver Semver) bool {
-	return (semver.Major == 4 && semver.Minor >= 13) || (semver.Major >= 5)
+// Appends default tags which are not already within tags.
+func mergeDefaultTags(tags []string, defaultTags []string) []string {
+	merged := append([]string{}, tags...)
+	for _, tag := range defaultTags {
+		if !slices.Contains(merged, tag) {
+			merged = append(merged, tag)
+		}
+	}
+	return merged
 }
 
-func hasProjectIsLatestFeature(semver Semver) bool {
-	return (semver.Major == 4 && semver.Minor >= 12) || (semver.Major >= 5)
+// Removes default tags from tagsAll, unless also within tags, so that default tags are not attributed to `tags`.
+func withoutDefaultTags(tagsAll []string, tags []string, defaultTags []string) []string {
+	return Filter(tagsAll, func(tag string) bool {
+		return !slices.Contains(defaultTags, tag) || slices.Contains(tags, tag)
+	})
 }
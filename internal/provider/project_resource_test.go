@@ -1,9 +1,13 @@
 package provider
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccProjectResource(t *testing.T) {
@@ -35,6 +39,12 @@ resource "dependencytrack_project" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "dependencytrack_project.test",
+				ImportState:       true,
+				ImportStateId:     "Test_Project@",
+				ImportStateVerify: true,
+			},
 			// Update and Read testing.
 			{
 				Config: providerConfig + `
@@ -151,6 +161,32 @@ resource "dependencytrack_project" "test" {
 	})
 }
 
+func TestAccProjectResourceIdentity(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_Project_Resource_Identity"
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentityValueMatchesState("dependencytrack_project.test", tfjsonpath.New("id")),
+				},
+			},
+			{
+				ResourceName:    "dependencytrack_project.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
+		},
+	})
+}
+
 func TestAccProjectVersion(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -196,8 +232,8 @@ resource "dependencytrack_project" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.0", "test_project_tags_tag1"),
-					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.1", "test_project_tags_tag2"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags.*", "test_project_tags_tag1"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags.*", "test_project_tags_tag2"),
 				),
 			},
 			// ImportState.
@@ -217,14 +253,83 @@ resource "dependencytrack_project" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.0", "test_project_tags_tag1"),
-					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.1", "test_project_tags_tag2_with_change"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags.*", "test_project_tags_tag1"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags.*", "test_project_tags_tag2_with_change"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProjectDefaultTags(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read.
+			{
+				Config: providerConfigWith(`default_tags = ["test_default_tags_team", "test_default_tags_env"]`) + `
+resource "dependencytrack_project" "test" {
+	name = "Test Project With Default Tags"
+	tags = ["test_default_tags_own"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.#", "1"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags.*", "test_default_tags_own"),
+					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags_all.#", "3"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags_all.*", "test_default_tags_own"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags_all.*", "test_default_tags_team"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.test", "tags_all.*", "test_default_tags_env"),
+				),
+			},
+			// ImportState.
+			{
+				ResourceName:      "dependencytrack_project.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read, with a default tag also within tags.
+			{
+				Config: providerConfigWith(`default_tags = ["test_default_tags_team", "test_default_tags_env"]`) + `
+resource "dependencytrack_project" "test" {
+	name = "Test Project With Default Tags"
+	tags = ["test_default_tags_own", "test_default_tags_env"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags_all.#", "3"),
+				),
+			},
+			// Update and Read, with default tags removed.
+			{
+				Config: providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test Project With Default Tags"
+	tags = ["test_default_tags_own", "test_default_tags_env"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags.#", "2"),
+					resource.TestCheckResourceAttr("dependencytrack_project.test", "tags_all.#", "2"),
 				),
 			},
 		},
 	})
 }
 
+func TestMergeDefaultTags(t *testing.T) {
+	merged := mergeDefaultTags([]string{"own", "env"}, []string{"team", "env"})
+	requireEqual(t, strings.Join(merged, ","), "own,env,team")
+	requireEqual(t, len(mergeDefaultTags(nil, nil)), 0)
+	requireEqual(t, mergeDefaultTags(nil, nil) != nil, true)
+
+	attributed := withoutDefaultTags([]string{"own", "env", "team"}, []string{"own", "env"}, []string{"team", "env"})
+	requireEqual(t, strings.Join(attributed, ","), "own,env")
+	attributed = withoutDefaultTags([]string{"own", "env", "team"}, []string{}, []string{"team", "env"})
+	requireEqual(t, strings.Join(attributed, ","), "own")
+}
+
 func TestAccProjectTagsRead(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
@@ -257,8 +362,8 @@ data "dependencytrack_project" "project2" {
 					// State obtained prior to it having the tag applied, so expect to not be aware of tags.
 					resource.TestCheckResourceAttr("dependencytrack_project.project2", "tags.#", "0"),
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.projects", "projects.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_projects.projects", "projects.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_projects.projects", "projects.*",
 						"dependencytrack_project.project2", "id",
 					),
 					resource.TestCheckResourceAttr("data.dependencytrack_project.project2", "tags.#", "1"),
@@ -299,10 +404,10 @@ data "dependencytrack_project" "project2" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_project.project2", "tags.#", "1"),
-					resource.TestCheckResourceAttr("dependencytrack_project.project2", "tags.0", "test_project_tags_read_tag"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.project2", "tags.*", "test_project_tags_read_tag"),
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.projects", "projects.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_projects.projects", "projects.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_projects.projects", "projects.*",
 						"dependencytrack_project.project2", "id",
 					),
 					resource.TestCheckResourceAttr("data.dependencytrack_project.project2", "tags.#", "1"),
@@ -476,8 +581,8 @@ resource "dependencytrack_project" "example" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_project.example", "tags.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_project.example", "tags.0", "collection-example"),
-					resource.TestCheckResourceAttr("dependencytrack_project.example", "tags.1", "environment-test"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.example", "tags.*", "collection-example"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.example", "tags.*", "environment-test"),
 				),
 			},
 			// Update and Read testing.
@@ -494,8 +599,8 @@ resource "dependencytrack_project" "example" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_project.example", "tags.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_project.example", "tags.0", "collection-example"),
-					resource.TestCheckResourceAttr("dependencytrack_project.example", "tags.1", "environment-test"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.example", "tags.*", "collection-example"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_project.example", "tags.*", "environment-test"),
 				),
 			},
 		},
@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource               = &projectVEXResource{}
+	_ resource.ResourceWithConfigure  = &projectVEXResource{}
+	_ resource.ResourceWithModifyPlan = &projectVEXResource{}
+
+	projectVEXAnalysisType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"component":     types.StringType,
+		"vulnerability": types.StringType,
+		"vuln_id":       types.StringType,
+		"source":        types.StringType,
+		"state":         types.StringType,
+		"suppressed":    types.BoolType,
+	}}
+)
+
+type (
+	projectVEXResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	projectVEXResourceModel struct {
+		Project       types.String `tfsdk:"project"`
+		Path          types.String `tfsdk:"path"`
+		Content       types.String `tfsdk:"content"`
+		ContentSHA256 types.String `tfsdk:"content_sha256"`
+		Token         types.String `tfsdk:"token"`
+		Timeout       types.String `tfsdk:"timeout"`
+		Analyses      types.List   `tfsdk:"analyses"`
+	}
+
+	projectVEXAnalysisModel struct {
+		Component     types.String `tfsdk:"component"`
+		Vulnerability types.String `tfsdk:"vulnerability"`
+		VulnID        types.String `tfsdk:"vuln_id"`
+		Source        types.String `tfsdk:"source"`
+		State         types.String `tfsdk:"state"`
+		Suppressed    types.Bool   `tfsdk:"suppressed"`
+	}
+
+	// Subset of a CycloneDX VEX document, in JSON or XML, identifying the vulnerabilities it asserts.
+	cycloneDXVulnerabilities struct {
+		Vulnerabilities []struct {
+			ID     string `json:"id" xml:"id"`
+			Source struct {
+				Name string `json:"name" xml:"name"`
+			} `json:"source" xml:"source"`
+		} `json:"vulnerabilities" xml:"vulnerabilities>vulnerability"`
+	}
+
+	// Vulnerability asserted within a VEX document, with Source empty when not stated.
+	vexVulnerability struct {
+		ID     string
+		Source string
+	}
+)
+
+func NewProjectVEXResource() resource.Resource {
+	return &projectVEXResource{}
+}
+
+func (*projectVEXResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_vex"
+}
+
+func (*projectVEXResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Uploads a CycloneDX VEX document to a Project, and waits for DependencyTrack to apply its analyses. " +
+			"The VEX is uploaded again whenever its content changes. " +
+			"Destroying this resource does not revert the analyses which were applied.",
+		Attributes: map[string]schema.Attribute{
+			"project": schema.StringAttribute{
+				Description: "UUID for the Project to which to upload the VEX.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Description: "Path to a file containing the VEX, in CycloneDX JSON or XML. Exactly one of 'path' or 'content' must be set.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.ExactlyOneOf(path.MatchRoot("content"))},
+			},
+			"content": schema.StringAttribute{
+				Description: "Content of the VEX, in CycloneDX JSON or XML.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"content_sha256": schema.StringAttribute{
+				Description: "SHA256 hash of the uploaded VEX, as hex. Changes to the content of the VEX, including the file at 'path', cause it to be uploaded again.",
+				Computed:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Token of the most recent upload, used to check whether it has been processed.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "Duration to wait for DependencyTrack to process the VEX, such as '30s' or '10m'. " +
+					"Defaults to '" + DefaultUploadTimeout.String() + "'.",
+				Optional: true,
+			},
+			"analyses": schema.ListNestedAttribute{
+				Description: "Analyses of the Project's findings for vulnerabilities within the VEX, matched by `id` and, when present, `source.name`. " +
+					"The findings are those found once the most recent upload was processed, with their analyses refreshed on each read. " +
+					"Vulnerabilities within the VEX which are not findings of the Project are omitted.",
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"component": schema.StringAttribute{
+							Description: "UUID of the Component affected by the vulnerability.",
+							Computed:    true,
+						},
+						"vulnerability": schema.StringAttribute{
+							Description: "UUID of the Vulnerability.",
+							Computed:    true,
+						},
+						"vuln_id": schema.StringAttribute{
+							Description: "ID of the Vulnerability within its source, such as 'CVE-2021-44228'.",
+							Computed:    true,
+						},
+						"source": schema.StringAttribute{
+							Description: "Source of the Vulnerability, such as 'NVD' or 'GITHUB'.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "Analysis state of the finding, one of " + describeValues(analysisStateValues) + ".",
+							Computed:    true,
+						},
+						"suppressed": schema.BoolAttribute{
+							Description: "Whether the finding is suppressed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *projectVEXResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan projectVEXResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Project VEX", map[string]any{
+		"project": plan.Project.ValueString(),
+	})
+	r.upload(ctx, &plan, LifecycleCreate, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Created Project VEX", map[string]any{
+		"project": plan.Project.ValueString(),
+		"token":   plan.Token.ValueString(),
+	})
+}
+
+func (r *projectVEXResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	readUpload(ctx, r.client, req, resp, "VEX")
+	if resp.Diagnostics.HasError() || resp.State.Raw.IsNull() {
+		return
+	}
+
+	// Analyses may since have been amended, so are refreshed for the findings recorded by the most recent upload.
+	var state projectVEXResourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var recorded []projectVEXAnalysisModel
+	resp.Diagnostics.Append(state.Analyses.ElementsAs(ctx, &recorded, false)...)
+	projectID, diagnostic := TryParseUUID(state.Project, LifecycleRead, path.Root("project"))
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Analyses = r.findAnalyses(ctx, projectID, LifecycleRead, &resp.Diagnostics, func(finding dtrack.Finding) bool {
+		return slices.ContainsFunc(recorded, func(analysis projectVEXAnalysisModel) bool {
+			return analysis.Component.ValueString() == finding.Component.UUID.String() &&
+				analysis.Vulnerability.ValueString() == finding.Vulnerability.UUID.String()
+		})
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *projectVEXResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan projectVEXResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Project VEX", map[string]any{
+		"project": plan.Project.ValueString(),
+	})
+	// 'token' and 'analyses' are kept from state when the VEX is unchanged, such as when only 'path' or 'timeout' changed.
+	if plan.Token.IsUnknown() {
+		r.upload(ctx, &plan, LifecycleUpdate, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Updated Project VEX", map[string]any{
+		"project": plan.Project.ValueString(),
+		"token":   plan.Token.ValueString(),
+	})
+}
+
+func (*projectVEXResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Analyses applied from the VEX are retained, as they may since have been amended, so the resource is only removed from state.
+	deleteUpload(ctx, req, resp, "VEX")
+}
+
+// Uploads the VEX, waits for it to be processed, and updates the computed attributes of plan.
+func (r *projectVEXResource) upload(ctx context.Context, plan *projectVEXResourceModel, lifecycle LifecycleAction, diagnostics *diag.Diagnostics) {
+	projectID, diagnostic := TryParseUUID(plan.Project, lifecycle, path.Root("project"))
+	if diagnostic != nil {
+		diagnostics.Append(diagnostic)
+		return
+	}
+	content, _, err := readUploadContent(plan.Path, plan.Content)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Within "+string(lifecycle)+", unable to read VEX",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	hash := uploadContentHash(content)
+	if !plan.ContentSHA256.IsUnknown() && plan.ContentSHA256.ValueString() != hash {
+		diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Within "+string(lifecycle)+", VEX changed since plan",
+			fmt.Sprintf("Planned VEX with SHA256 '%s', but found '%s'. Run plan again.", plan.ContentSHA256.ValueString(), hash),
+		)
+		return
+	}
+	vulnerabilities, err := parseVEXVulnerabilities(content)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("content"),
+			"Within "+string(lifecycle)+", unable to parse VEX",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	timeout := parseDuration(plan.Timeout, DefaultUploadTimeout, path.Root("timeout"), diagnostics)
+	if diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.VEX.Upload(ctx, dtrack.VEXUploadRequest{
+		ProjectUUID: &projectID,
+		VEX:         base64.StdEncoding.EncodeToString([]byte(content)),
+	})
+	if err != nil {
+		diagnostics.AddError(
+			"Within "+string(lifecycle)+", unable to upload VEX",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	err = waitForUploadProcessing(ctx, r.client, r.semver, string(token), timeout)
+	if err != nil {
+		diagnostics.AddError(
+			"Within "+string(lifecycle)+", VEX was not processed",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+
+	analyses := r.findAnalyses(ctx, projectID, lifecycle, diagnostics, func(finding dtrack.Finding) bool {
+		return slices.ContainsFunc(vulnerabilities, func(vulnerability vexVulnerability) bool {
+			return vulnerability.matches(finding)
+		})
+	})
+	if diagnostics.HasError() {
+		return
+	}
+	plan.ContentSHA256 = types.StringValue(hash)
+	plan.Token = types.StringValue(string(token))
+	plan.Analyses = analyses
+}
+
+// Returns the analyses of the Project's findings, including those suppressed, for which match returns true.
+func (r *projectVEXResource) findAnalyses(
+	ctx context.Context, projectID uuid.UUID, lifecycle LifecycleAction, diagnostics *diag.Diagnostics, match func(dtrack.Finding) bool,
+) types.List {
+	findings, err := FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Finding], error) {
+		return r.client.Finding.GetAll(ctx, projectID, true, po)
+	}, match)
+	if err != nil {
+		diagnostics.AddError(
+			"Within "+string(lifecycle)+", unable to retrieve findings of project: "+projectID.String(),
+			"Error from: "+err.Error(),
+		)
+		return types.ListNull(projectVEXAnalysisType)
+	}
+	analyses, diags := types.ListValueFrom(ctx, projectVEXAnalysisType, Map(findings, func(finding dtrack.Finding) projectVEXAnalysisModel {
+		return projectVEXAnalysisModel{
+			Component:     types.StringValue(finding.Component.UUID.String()),
+			Vulnerability: types.StringValue(finding.Vulnerability.UUID.String()),
+			VulnID:        types.StringValue(finding.Vulnerability.VulnID),
+			Source:        types.StringValue(finding.Vulnerability.Source),
+			State:         types.StringValue(finding.Analysis.State),
+			Suppressed:    types.BoolValue(finding.Analysis.Suppressed),
+		}
+	}))
+	diagnostics.Append(diags...)
+	return analyses
+}
+
+func (*projectVEXResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	validate := func(content string) error {
+		_, err := parseVEXVulnerabilities(content)
+		return err
+	}
+	modifyUploadPlan(ctx, req, resp, "VEX", validate, map[string]attr.Value{
+		"analyses": types.ListUnknown(projectVEXAnalysisType),
+	})
+}
+
+func (r *projectVEXResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
+
+// Returns the vulnerabilities within a CycloneDX VEX document, in either JSON or XML.
+func parseVEXVulnerabilities(content string) ([]vexVulnerability, error) {
+	var document cycloneDXVulnerabilities
+	var err error
+	if strings.HasPrefix(strings.TrimSpace(content), "<") {
+		err = xml.Unmarshal([]byte(content), &document)
+	} else {
+		err = json.Unmarshal([]byte(content), &document)
+	}
+	if err != nil {
+		return nil, err
+	}
+	vulnerabilities := []vexVulnerability{}
+	for _, vulnerability := range document.Vulnerabilities {
+		parsed := vexVulnerability{ID: vulnerability.ID, Source: vulnerability.Source.Name}
+		if parsed.ID != "" && !slices.Contains(vulnerabilities, parsed) {
+			vulnerabilities = append(vulnerabilities, parsed)
+		}
+	}
+	return vulnerabilities, nil
+}
+
+// Whether finding is for the vulnerability, matching on source only when stated within the VEX.
+func (v vexVulnerability) matches(finding dtrack.Finding) bool {
+	return v.ID == finding.Vulnerability.VulnID && (v.Source == "" || strings.EqualFold(v.Source, finding.Vulnerability.Source))
+}
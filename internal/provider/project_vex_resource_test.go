@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestParseVEXVulnerabilities(t *testing.T) {
+	{
+		vulnerabilities, err := parseVEXVulnerabilities(`{"bomFormat":"CycloneDX","vulnerabilities":[
+			{"id":"CVE-2021-44228","source":{"name":"NVD"}},
+			{"id":"CVE-2021-45046"},
+			{"id":"CVE-2021-44228","source":{"name":"NVD"}},
+			{"id":"CVE-2021-44228","source":{"name":"OSV"}}
+		]}`)
+		requireNoError(t, err)
+		requireEqual(t, slices.Equal(vulnerabilities, []vexVulnerability{
+			{ID: "CVE-2021-44228", Source: "NVD"},
+			{ID: "CVE-2021-45046", Source: ""},
+			{ID: "CVE-2021-44228", Source: "OSV"},
+		}), true)
+	}
+	{
+		vulnerabilities, err := parseVEXVulnerabilities(`
+<?xml version="1.0"?>
+<bom xmlns="http://cyclonedx.org/schema/bom/1.5" version="1">
+	<vulnerabilities>
+		<vulnerability><id>GHSA-jfh8-c2jp-5v3q</id><source><name>GITHUB</name></source></vulnerability>
+	</vulnerabilities>
+</bom>`)
+		requireNoError(t, err)
+		requireEqual(t, slices.Equal(vulnerabilities, []vexVulnerability{{ID: "GHSA-jfh8-c2jp-5v3q", Source: "GITHUB"}}), true)
+	}
+	{
+		vulnerabilities, err := parseVEXVulnerabilities(`{"bomFormat":"CycloneDX"}`)
+		requireNoError(t, err)
+		requireEqual(t, len(vulnerabilities), 0)
+	}
+	{
+		_, err := parseVEXVulnerabilities(`{"bomFormat":`)
+		requireError(t, err, "^unexpected end of JSON input$")
+	}
+}
+
+func TestVEXVulnerabilityMatches(t *testing.T) {
+	finding := dtrack.Finding{}
+	finding.Vulnerability.VulnID = "CVE-2021-44228"
+	finding.Vulnerability.Source = "NVD"
+	requireEqual(t, vexVulnerability{ID: "CVE-2021-44228", Source: "NVD"}.matches(finding), true)
+	requireEqual(t, vexVulnerability{ID: "CVE-2021-44228", Source: "nvd"}.matches(finding), true)
+	requireEqual(t, vexVulnerability{ID: "CVE-2021-44228", Source: ""}.matches(finding), true)
+	requireEqual(t, vexVulnerability{ID: "CVE-2021-44228", Source: "OSV"}.matches(finding), false)
+	requireEqual(t, vexVulnerability{ID: "CVE-2021-45046", Source: "NVD"}.matches(finding), false)
+}
+
+const testAccProjectVEX = `{
+	"bomFormat": "CycloneDX",
+	"specVersion": "1.5",
+	"version": 1,
+	"vulnerabilities": [
+		{
+			"id": "CVE-2021-44228",
+			"source": {"name": "NVD"},
+			"analysis": {"state": "%s", "justification": "code_not_reachable", "detail": "JNDI lookups are disabled."},
+			"affects": [{"ref": "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"}]
+		}
+	]
+}`
+
+func TestAccProjectVEXResource(t *testing.T) {
+	config := func(state string) string {
+		return providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_Project_VEX"
+}
+resource "dependencytrack_project_bom" "test" {
+	project = dependencytrack_project.test.id
+	content = jsonencode({
+		bomFormat = "CycloneDX"
+		specVersion = "1.5"
+		version = 1
+		components = [{
+			type = "library"
+			"bom-ref" = "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"
+			group = "org.apache.logging.log4j"
+			name = "log4j-core"
+			version = "2.14.1"
+			purl = "pkg:maven/org.apache.logging.log4j/log4j-core@2.14.1"
+		}]
+	})
+}
+resource "dependencytrack_project_vex" "test" {
+	project = dependencytrack_project_bom.test.project
+	content = format(<<-EOT
+` + testAccProjectVEX + `
+EOT
+	, "` + state + `")
+}
+`
+	}
+	// Attributes of the VEX, once updated, to amend its analyses outside of Terraform.
+	var attributes map[string]string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("not_affected"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_project_vex.test", "project",
+						"dependencytrack_project.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_vex.test", "content_sha256"),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_vex.test", "token"),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_vex.test", "analyses.#"),
+				),
+			},
+			{
+				Config: config("in_triage"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dependencytrack_project_vex.test", "token"),
+					resource.TestCheckResourceAttrSet("dependencytrack_project_vex.test", "analyses.#"),
+					func(state *terraform.State) error {
+						attributes = state.RootModule().Resources["dependencytrack_project_vex.test"].Primary.Attributes
+						return nil
+					},
+				),
+			},
+			// Analyses amended outside of Terraform are refreshed within Read.
+			{
+				PreConfig: func() {
+					// Findings depend upon the vulnerability sources mirrored by DependencyTrack.
+					if attributes["analyses.#"] == "0" {
+						return
+					}
+					_, err := testAccClient(t).Analysis.Create(t.Context(), dtrack.AnalysisRequest{
+						Project:       uuid.MustParse(attributes["project"]),
+						Component:     uuid.MustParse(attributes["analyses.0.component"]),
+						Vulnerability: uuid.MustParse(attributes["analyses.0.vulnerability"]),
+						Comment:       "Changed outside of Terraform.",
+						State:         dtrack.AnalysisStateFalsePositive,
+						Justification: "",
+						Response:      "",
+						Details:       "",
+						Suppressed:    nil,
+					})
+					requireNoError(t, err)
+				},
+				RefreshState: true,
+				Check: func(state *terraform.State) error {
+					if attributes["analyses.#"] == "0" {
+						return nil
+					}
+					return resource.TestCheckResourceAttr("dependencytrack_project_vex.test", "analyses.0.state", "FALSE_POSITIVE")(state)
+				},
+			},
+		},
+	})
+}
@@ -3,25 +3,65 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"maps"
 	"net/http"
+	"net/url"
 	"os"
+	"slices"
 	"strings"
+	"time"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	EnvHost           = "DEPENDENCYTRACK_HOST"
+	EnvAPIKey         = "DEPENDENCYTRACK_API_KEY"
+	EnvBearerToken    = "DEPENDENCYTRACK_BEARER_TOKEN"
+	EnvRootCA         = "DEPENDENCYTRACK_ROOT_CA"
+	EnvRootCAFile     = "DEPENDENCYTRACK_ROOT_CA_FILE"
+	EnvClientCertFile = "DEPENDENCYTRACK_CLIENT_CERT"
+	EnvClientKeyFile  = "DEPENDENCYTRACK_CLIENT_KEY"
+	// EnvClientKeyPassword is used alongside EnvClientKeyFile, for an encrypted key.
+	EnvClientKeyPassword = "DEPENDENCYTRACK_CLIENT_KEY_PASSWORD"
+	EnvHeaders           = "DEPENDENCYTRACK_HEADERS"
+	EnvUsername          = "DEPENDENCYTRACK_USERNAME"
+	EnvPassword          = "DEPENDENCYTRACK_PASSWORD"
+	EnvOIDCTokenURL      = "DEPENDENCYTRACK_OIDC_TOKEN_URL"
+	EnvOIDCClientID      = "DEPENDENCYTRACK_OIDC_CLIENT_ID"
+	EnvOIDCSecret        = "DEPENDENCYTRACK_OIDC_CLIENT_SECRET"
+)
+
 // Ensure satisfies various provider interfaces.
 var (
-	_ provider.Provider = &dependencyTrackProvider{}
+	_ provider.Provider                       = &dependencyTrackProvider{}
+	_ provider.ProviderWithEphemeralResources = &dependencyTrackProvider{}
+	_ provider.ProviderWithListResources      = &dependencyTrackProvider{}
+	_ provider.ProviderWithFunctions          = &dependencyTrackProvider{}
+
+	tlsVersions = map[string]uint16{
+		"1.2": tls.VersionTLS12,
+		"1.3": tls.VersionTLS13,
+	}
 )
 
 type (
@@ -33,12 +73,20 @@ type (
 	}
 
 	dependencyTrackProviderModel struct {
-		Host    types.String                          `tfsdk:"host"`
-		Key     types.String                          `tfsdk:"key"`
-		Auth    *providerAuthModel                    `tfsdk:"auth"`
-		RootCA  types.String                          `tfsdk:"root_ca"`
-		MTLS    *dependencyTrackProviderMtlsModel     `tfsdk:"mtls"`
-		Headers []dependencyTrackProviderHeadersModel `tfsdk:"headers"`
+		Host     types.String                          `tfsdk:"host"`
+		Key      types.String                          `tfsdk:"key"`
+		Auth     *providerAuthModel                    `tfsdk:"auth"`
+		RootCA   types.String                          `tfsdk:"root_ca"`
+		MTLS     *dependencyTrackProviderMtlsModel     `tfsdk:"mtls"`
+		Headers  []dependencyTrackProviderHeadersModel `tfsdk:"headers"`
+		Retry    *dependencyTrackProviderRetryModel    `tfsdk:"retry"`
+		TLS      *dependencyTrackProviderTLSModel      `tfsdk:"tls"`
+		Timeout  types.String                          `tfsdk:"timeout"`
+		ProxyURL types.String                          `tfsdk:"proxy_url"`
+
+		MaxConcurrentRequests types.Int32 `tfsdk:"max_concurrent_requests"`
+		RequestsPerSecond     types.Int32 `tfsdk:"requests_per_second"`
+		DefaultTags           types.List  `tfsdk:"default_tags"`
 	}
 
 	dependencyTrackProviderHeadersModel struct {
@@ -47,19 +95,44 @@ type (
 	}
 
 	dependencyTrackProviderMtlsModel struct {
-		KeyPath  types.String `tfsdk:"key_path"`
-		CertPath types.String `tfsdk:"cert_path"`
+		KeyPath      types.String `tfsdk:"key_path"`
+		CertPath     types.String `tfsdk:"cert_path"`
+		KeyPEM       types.String `tfsdk:"key_pem"`
+		CertPEM      types.String `tfsdk:"cert_pem"`
+		KeyPassword  types.String `tfsdk:"key_password"`
+		PKCS12Path   types.String `tfsdk:"pkcs12_path"`
+		PKCS12Base64 types.String `tfsdk:"pkcs12_base64"`
+	}
+
+	dependencyTrackProviderTLSModel struct {
+		MinVersion         types.String `tfsdk:"min_version"`
+		ServerName         types.String `tfsdk:"server_name"`
+		InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	}
+
+	dependencyTrackProviderRetryModel struct {
+		MaxAttempts types.Int32  `tfsdk:"max_attempts"`
+		MinWait     types.String `tfsdk:"min_wait"`
+		MaxWait     types.String `tfsdk:"max_wait"`
 	}
 
 	providerAuthModel struct {
-		Type   types.String `tfsdk:"type"`
-		Key    types.String `tfsdk:"key"`
-		Bearer types.String `tfsdk:"bearer"`
+		Type         types.String `tfsdk:"type"`
+		Key          types.String `tfsdk:"key"`
+		Bearer       types.String `tfsdk:"bearer"`
+		Username     types.String `tfsdk:"username"`
+		Password     types.String `tfsdk:"password"`
+		TokenURL     types.String `tfsdk:"token_url"`
+		ClientID     types.String `tfsdk:"client_id"`
+		ClientSecret types.String `tfsdk:"client_secret"`
+		Scopes       types.List   `tfsdk:"scopes"`
 	}
 
 	clientInfo struct {
-		client *dtrack.Client
-		semver *Semver
+		client      *dtrack.Client
+		api         apiClient
+		semver      *Semver
+		defaultTags []string
 	}
 )
 
@@ -70,23 +143,26 @@ func (p *dependencyTrackProvider) Metadata(_ context.Context, _ provider.Metadat
 
 func (*dependencyTrackProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Interact with DependencyTrack.",
+		Description: "Interact with DependencyTrack. " +
+			"Set '" + EnvHTTPLog + "' environment variable to 'DEBUG' or 'TRACE' to log each request to DependencyTrack API, with credentials redacted.",
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Description: "URI for DependencyTrack API.",
-				Required:    true,
+				Description: "URI for DependencyTrack API. If unset, read from '" + EnvHost + "' environment variable.",
+				Optional:    true,
 			},
 			"key": schema.StringAttribute{
 				Description: "API Key for authentication to DependencyTrack. " +
 					"Must have permissions for all attempted actions. " +
-					"Set to 'OS_ENV' to read from 'DEPENDENCYTRACK_API_KEY' environment variable. " +
-					"If unset, then 'auth' block must be provided.",
+					"Set to 'OS_ENV' to read from '" + EnvAPIKey + "' environment variable. " +
+					"If unset, then 'auth' block must be provided, " +
+					"unless '" + EnvAPIKey + "' or '" + EnvBearerToken + "' environment variable is set.",
 				Optional:  true,
 				Sensitive: true,
 			},
 			"headers": schema.ListNestedAttribute{
-				Description: "Add additional headers to client API requests. Useful for proxy authentication.",
-				Optional:    true,
+				Description: "Add additional headers to client API requests. Useful for proxy authentication. " +
+					"If unset, read from '" + EnvHeaders + "' environment variable, as a JSON object of header name to value.",
+				Optional: true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -105,37 +181,168 @@ func (*dependencyTrackProvider) Schema(_ context.Context, _ provider.SchemaReque
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"type": schema.StringAttribute{
-						Description: "The authentication method to use. Valid values are: 'NONE', 'KEY', 'BEARER'.",
+						Description: "The authentication method to use. Valid values are: 'NONE', 'KEY', 'BEARER', 'CREDENTIALS', 'OIDC_CLIENT_CREDENTIALS'.",
 						Required:    true,
 					},
 					"key": schema.StringAttribute{
-						Description: "API Key for DependencyTrack. Set to 'OS_ENV' to read from 'DEPENDENCYTRACK_API_KEY' environment variable. " +
+						Description: "API Key for DependencyTrack. Set to 'OS_ENV' to read from '" + EnvAPIKey + "' environment variable. " +
 							"Must be provided if 'type' is set to 'KEY'.",
 						Optional:  true,
 						Sensitive: true,
 					},
 					"bearer": schema.StringAttribute{
-						Description: "Bearer token from DependencyTrack. Must be provided if 'type' is set to 'BEARER'.",
+						Description: "Bearer token from DependencyTrack. Must be provided if 'type' is set to 'BEARER', " +
+							"unless '" + EnvBearerToken + "' environment variable is set.",
+						Optional:  true,
+						Sensitive: true,
+					},
+					"username": schema.StringAttribute{
+						Description: "Username of a managed user, exchanged for a token at configure time, and again whenever the token expires. " +
+							"Must be provided if 'type' is set to 'CREDENTIALS', unless '" + EnvUsername + "' environment variable is set.",
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Description: "Password of the managed user in 'username'. " +
+							"Must be provided if 'type' is set to 'CREDENTIALS', unless '" + EnvPassword + "' environment variable is set.",
+						Optional:  true,
+						Sensitive: true,
+					},
+					"token_url": schema.StringAttribute{
+						Description: "Token endpoint of the OIDC identity provider, from which to obtain a token using the OAuth2 client credentials grant. " +
+							"Uses the same TLS and proxy configuration as requests to DependencyTrack API. " +
+							"Must be provided if 'type' is set to 'OIDC_CLIENT_CREDENTIALS', unless '" + EnvOIDCTokenURL + "' environment variable is set.",
+						Optional: true,
+					},
+					"client_id": schema.StringAttribute{
+						Description: "OAuth2 Client ID. " +
+							"Must be provided if 'type' is set to 'OIDC_CLIENT_CREDENTIALS', unless '" + EnvOIDCClientID + "' environment variable is set.",
+						Optional: true,
+					},
+					"client_secret": schema.StringAttribute{
+						Description: "OAuth2 Client Secret. " +
+							"Must be provided if 'type' is set to 'OIDC_CLIENT_CREDENTIALS', unless '" + EnvOIDCSecret + "' environment variable is set.",
+						Optional:  true,
+						Sensitive: true,
+					},
+					"scopes": schema.ListAttribute{
+						Description: "Scopes to request from the OIDC identity provider, when 'type' is set to 'OIDC_CLIENT_CREDENTIALS'.",
 						Optional:    true,
-						Sensitive:   true,
+						ElementType: types.StringType,
 					},
 				},
 			},
 			"root_ca": schema.StringAttribute{
-				Description: "Root CA Certificate(s) used for TLS connection to DependencyTrack API in PEM format.",
-				Optional:    true,
+				Description: "Root CA Certificate(s) used for TLS connection to DependencyTrack API in PEM format. " +
+					"If unset, read from '" + EnvRootCA + "' environment variable, or from the file at the path in '" + EnvRootCAFile + "'.",
+				Optional: true,
 			},
 			"mtls": schema.SingleNestedAttribute{
-				Description: "Client Key and Certificate paths to use for mTLS connection to DependencyTrack API.",
-				Optional:    true,
+				Description: "Client Key and Certificate to use for mTLS connection to DependencyTrack API. " +
+					"Exactly one of 'key_path' and 'cert_path', 'key_pem' and 'cert_pem', 'pkcs12_path', or 'pkcs12_base64' must be provided. " +
+					"If unset, read from '" + EnvClientKeyFile + "', '" + EnvClientCertFile + "' and '" + EnvClientKeyPassword + "' environment variables.",
+				Optional: true,
 				Attributes: map[string]schema.Attribute{
 					"key_path": schema.StringAttribute{
-						Description: "Path to the file containing the client key.",
-						Required:    true,
+						Description: "Path to the file containing the client key in PEM format.",
+						Optional:    true,
 					},
 					"cert_path": schema.StringAttribute{
-						Description: "Path to the file containing the client certificate.",
-						Required:    true,
+						Description: "Path to the file containing the client certificate in PEM format.",
+						Optional:    true,
+					},
+					"key_pem": schema.StringAttribute{
+						Description: "Client key in PEM format. Alternative to 'key_path'.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"cert_pem": schema.StringAttribute{
+						Description: "Client certificate in PEM format, optionally followed by intermediate certificates. Alternative to 'cert_path'.",
+						Optional:    true,
+					},
+					"key_password": schema.StringAttribute{
+						Description: "Password for an encrypted PKCS#8 client key, with PEM type 'ENCRYPTED PRIVATE KEY', or for a PKCS#12 bundle.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+					"pkcs12_path": schema.StringAttribute{
+						Description: "Path to a PKCS#12 bundle containing the client key and certificate.",
+						Optional:    true,
+					},
+					"pkcs12_base64": schema.StringAttribute{
+						Description: "Base64 encoded PKCS#12 bundle containing the client key and certificate. Alternative to 'pkcs12_path'.",
+						Optional:    true,
+						Sensitive:   true,
+					},
+				},
+			},
+			"tls": schema.SingleNestedAttribute{
+				Description: "TLS options for the connection to DependencyTrack API.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"min_version": schema.StringAttribute{
+						Description: "Minimum TLS version to accept. Valid values are: '1.2', '1.3'. Defaults to '1.3'.",
+						Optional:    true,
+						Validators:  []validator.String{stringvalidator.OneOf(tlsVersionNames()...)},
+					},
+					"server_name": schema.StringAttribute{
+						Description: "Override of the server name used for certificate verification and SNI. Useful when connecting via an IP address or tunnel.",
+						Optional:    true,
+					},
+					"insecure_skip_verify": schema.BoolAttribute{
+						Description: "Disables verification of the server certificate chain and host name. " +
+							"This is insecure, allowing for man-in-the-middle attacks, and should only be used for testing. Defaults to false.",
+						Optional: true,
+					},
+				},
+			},
+			"timeout": schema.StringAttribute{
+				Description: "Timeout for each request to DependencyTrack API, as a duration such as '30s' or '2m'. " +
+					"Applied to each attempt when retrying. Set to '0s' to disable. Defaults to '" + dtrack.DefaultTimeout.String() + "'.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				Description: "URL of a proxy to use for requests to DependencyTrack API, such as 'http://proxy.example.com:3128'. " +
+					"If unset, proxy is read from 'HTTPS_PROXY', 'HTTP_PROXY' and 'NO_PROXY' environment variables.",
+				Optional: true,
+			},
+			"default_tags": schema.ListAttribute{
+				Description: "Tags to assign to every `dependencytrack_project`, in addition to its `tags`. " +
+					"Default tags are included within `tags_all` of each project, rather than within `tags`.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"max_concurrent_requests": schema.Int32Attribute{
+				Description: "Maximum number of concurrent requests to DependencyTrack API, across all resources and data sources. " +
+					"Requests beyond this are queued. If unset, requests are not limited.",
+				Optional:   true,
+				Validators: []validator.Int32{int32validator.AtLeast(1)},
+			},
+			"requests_per_second": schema.Int32Attribute{
+				Description: "Maximum rate of requests to DependencyTrack API, across all resources and data sources. " +
+					"Requests beyond this are queued. If unset, requests are not limited.",
+				Optional:   true,
+				Validators: []validator.Int32{int32validator.AtLeast(1)},
+			},
+			"retry": schema.SingleNestedAttribute{
+				Description: "Retry behaviour for requests to DependencyTrack API which fail with 429, 502, 503, 504, or a connection reset. " +
+					"Only GET, HEAD, OPTIONS and DELETE requests are retried, unless the request failed before being sent, such as when the connection was refused. " +
+					fmt.Sprintf("If unset, requests are attempted up to %d times.", DefaultRetryMaxAttempts),
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int32Attribute{
+						Description: fmt.Sprintf("Maximum number of attempts for each request, including the first. Set to 1 to disable retries. Defaults to %d.", DefaultRetryMaxAttempts),
+						Optional:    true,
+						Validators:  []validator.Int32{int32validator.AtLeast(1)},
+					},
+					"min_wait": schema.StringAttribute{
+						Description: "Minimum wait between attempts, as a duration such as '500ms' or '2s'. Doubles on each retry, with jitter. " +
+							"Defaults to '" + DefaultRetryMinWait.String() + "'.",
+						Optional: true,
+					},
+					"max_wait": schema.StringAttribute{
+						Description: "Maximum wait between attempts, as a duration such as '30s'. Also caps any 'Retry-After' sent by the server. " +
+							"Defaults to '" + DefaultRetryMaxWait.String() + "'.",
+						Optional: true,
 					},
 				},
 			},
@@ -152,12 +359,12 @@ func (*dependencyTrackProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
-	host := config.Host.ValueString()
+	host := stringFromConfigOrEnv(config.Host, EnvHost)
 	if host == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing DependencyTrack Host",
-			"Host for DependencyTrack was provided, but it was empty.",
+			"Host for DependencyTrack must be provided, either using the 'host' attribute, or the '"+EnvHost+"' environment variable.",
 		)
 	}
 	authClientOption := getAuthClientOption(config, &resp.Diagnostics)
@@ -167,6 +374,11 @@ func (*dependencyTrackProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	configureTokenAuth(ctx, host, config.Auth, httpClient, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Debug(ctx, "Creating DependencyTrack client")
 	client, err := dtrack.NewClient(host, dtrack.WithHttpClient(httpClient), authClientOption)
 	if err != nil {
@@ -177,6 +389,8 @@ func (*dependencyTrackProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	api := newAPIClient(httpClient, client)
+
 	version, err := client.About.Get(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -194,13 +408,39 @@ func (*dependencyTrackProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
+	defaultTags, err := GetStringList(ctx, &resp.Diagnostics, config.DefaultTags)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_tags"),
+			"Unable to load default tags",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+
 	resp.DataSourceData = clientInfo{
-		client: client,
-		semver: semver,
+		client:      client,
+		api:         api,
+		semver:      semver,
+		defaultTags: defaultTags,
 	}
 	resp.ResourceData = clientInfo{
-		client: client,
-		semver: semver,
+		client:      client,
+		api:         api,
+		semver:      semver,
+		defaultTags: defaultTags,
+	}
+	resp.EphemeralResourceData = clientInfo{
+		client:      client,
+		api:         api,
+		semver:      semver,
+		defaultTags: defaultTags,
+	}
+	resp.ListResourceData = clientInfo{
+		client:      client,
+		api:         api,
+		semver:      semver,
+		defaultTags: defaultTags,
 	}
 	tflog.Debug(ctx, "Configured DependencyTrack client", map[string]any{
 		"success": true,
@@ -211,6 +451,11 @@ func (*dependencyTrackProvider) Resources(_ context.Context) []func() resource.R
 	return []func() resource.Resource{
 		NewProjectResource,
 		NewProjectPropertyResource,
+		NewProjectBOMResource,
+		NewProjectVEXResource,
+		NewAnalysisResource,
+		NewViolationAnalysisResource,
+		NewVulnerabilityResource,
 		NewTeamResource,
 		NewTeamPermissionResource,
 		NewTeamAPIKeyResource,
@@ -248,6 +493,7 @@ func (*dependencyTrackProvider) DataSources(_ context.Context) []func() datasour
 	return []func() datasource.DataSource{
 		NewProjectDataSource,
 		NewProjectPropertyDataSource,
+		NewVulnerabilityDataSource,
 		NewTeamDataSource,
 		NewConfigPropertyDataSource,
 		NewComponentsDataSource,
@@ -262,6 +508,35 @@ func (*dependencyTrackProvider) DataSources(_ context.Context) []func() datasour
 	}
 }
 
+func (*dependencyTrackProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTeamAPIKeyEphemeralResource,
+		NewOidcLoginEphemeralResource,
+		NewUserLoginEphemeralResource,
+	}
+}
+
+func (*dependencyTrackProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewProjectListResource,
+		NewTeamListResource,
+		NewPolicyListResource,
+		NewNotificationRuleListResource,
+		NewRepositoryListResource,
+		NewUserListResource,
+	}
+}
+
+func (*dependencyTrackProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParsePURLFunction,
+		NewBuildPURLFunction,
+		NewParseCPEFunction,
+		NewBuildCPEFunction,
+		NewNormalizeSPDXExpressionFunction,
+	}
+}
+
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
 		return &dependencyTrackProvider{
@@ -271,21 +546,41 @@ func New(version string) func() provider.Provider {
 }
 
 func getHTTPClient(config dependencyTrackProviderModel, diagnostics *diag.Diagnostics) *http.Client {
-	headers := loadHeaders(config.Headers, diagnostics)
+	var headers []Header
+	if config.Headers != nil {
+		headers = loadHeaders(config.Headers, diagnostics)
+	} else {
+		headers = loadEnvHeaders(os.Getenv(EnvHeaders), diagnostics)
+	}
 	if diagnostics.HasError() {
 		return nil
 	}
 
-	// Set mTLS variables from Config.
-	clientCertFile := ""
-	clientKeyFile := ""
-	rootCAs := config.RootCA.ValueString()
-	if config.MTLS != nil {
-		clientCertFile = config.MTLS.CertPath.ValueString()
-		clientKeyFile = config.MTLS.KeyPath.ValueString()
+	clientCertificate := loadClientCertificate(config.MTLS, diagnostics)
+	rootCAs := loadRootCAs(config.RootCA, diagnostics)
+
+	retry := loadRetryOptions(config.Retry, diagnostics)
+	tlsOptions := loadTLSOptions(config.TLS, diagnostics)
+	timeout := parseDuration(config.Timeout, dtrack.DefaultTimeout, path.Root("timeout"), diagnostics)
+	proxyURL := loadProxyURL(config.ProxyURL, diagnostics)
+	if diagnostics.HasError() {
+		return nil
 	}
 
-	httpClient, err := NewHTTPClient(headers, []byte(rootCAs), clientCertFile, clientKeyFile)
+	httpClient, err := NewHTTPClient(HTTPClientOptions{
+		ProxyURL:          proxyURL,
+		ClientCertificate: clientCertificate,
+		Headers:           headers,
+		RootCAs:           []byte(rootCAs),
+		TLS:               tlsOptions,
+		Retry:             retry,
+		Limit: LimitOptions{
+			MaxConcurrentRequests: int(config.MaxConcurrentRequests.ValueInt32()),
+			RequestsPerSecond:     int(config.RequestsPerSecond.ValueInt32()),
+		},
+		Timeout:     timeout,
+		LogRequests: httpLogEnabled(),
+	})
 	if err != nil {
 		diagnostics.AddError(
 			"Unable to Create HTTP Client",
@@ -314,16 +609,344 @@ func loadHeaders(modelHeaders []dependencyTrackProviderHeadersModel, diagnostics
 	return headers
 }
 
+func loadEnvHeaders(value string, diagnostics *diag.Diagnostics) []Header {
+	if value == "" {
+		return []Header{}
+	}
+	envHeaders := map[string]string{}
+	err := json.Unmarshal([]byte(value), &envHeaders)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("headers"),
+			"Invalid headers environment variable",
+			"Expected '"+EnvHeaders+"' to be a JSON object of header name to value, from: "+err.Error(),
+		)
+		return nil
+	}
+	headers := make([]Header, 0, len(envHeaders))
+	for _, name := range slices.Sorted(maps.Keys(envHeaders)) {
+		if name == "" || envHeaders[name] == "" {
+			diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Missing header attributes",
+				fmt.Sprintf("Found Header Name: '%s', and Value: '%s', in '%s'.", name, envHeaders[name], EnvHeaders),
+			)
+			continue
+		}
+		headers = append(headers, Header{name, envHeaders[name]})
+	}
+	return headers
+}
+
+// Loads the mTLS client certificate from Config, falling back to environment. Returns nil when mTLS is not configured.
+func loadClientCertificate(model *dependencyTrackProviderMtlsModel, diagnostics *diag.Diagnostics) *tls.Certificate {
+	if model == nil {
+		model = loadEnvMtls(diagnostics)
+		if model == nil {
+			return nil
+		}
+	}
+	sources := Filter([]string{"key_path/cert_path", "key_pem/cert_pem", "pkcs12_path", "pkcs12_base64"}, func(source string) bool {
+		return slices.ContainsFunc(strings.Split(source, "/"), func(name string) bool {
+			return hasValue(mtlsAttributes(model)[name])
+		})
+	})
+	if len(sources) != 1 {
+		diagnostics.AddAttributeError(
+			path.Root("mtls"),
+			"Invalid mTLS configuration",
+			"Exactly one of 'key_path' and 'cert_path', 'key_pem' and 'cert_pem', 'pkcs12_path', or 'pkcs12_base64' must be provided. "+
+				fmt.Sprintf("Found: [%s].", strings.Join(sources, ", ")),
+		)
+		return nil
+	}
+	password := model.KeyPassword.ValueString()
+	switch sources[0] {
+	case "pkcs12_path", "pkcs12_base64":
+		return loadPKCS12Certificate(model, sources[0], password, diagnostics)
+	default:
+		return loadPEMCertificate(model, password, diagnostics)
+	}
+}
+
+func loadEnvMtls(diagnostics *diag.Diagnostics) *dependencyTrackProviderMtlsModel {
+	clientCertFile := os.Getenv(EnvClientCertFile)
+	clientKeyFile := os.Getenv(EnvClientKeyFile)
+	if clientCertFile == "" && clientKeyFile == "" {
+		return nil
+	}
+	if clientCertFile == "" || clientKeyFile == "" {
+		diagnostics.AddAttributeError(
+			path.Root("mtls"),
+			"Incomplete mTLS environment configuration",
+			"Both '"+EnvClientCertFile+"' and '"+EnvClientKeyFile+"' environment variables must be set, or neither.",
+		)
+		return nil
+	}
+	return &dependencyTrackProviderMtlsModel{
+		KeyPath:      types.StringValue(clientKeyFile),
+		CertPath:     types.StringValue(clientCertFile),
+		KeyPEM:       types.StringNull(),
+		CertPEM:      types.StringNull(),
+		KeyPassword:  types.StringValue(os.Getenv(EnvClientKeyPassword)),
+		PKCS12Path:   types.StringNull(),
+		PKCS12Base64: types.StringNull(),
+	}
+}
+
+func loadPEMCertificate(model *dependencyTrackProviderMtlsModel, password string, diagnostics *diag.Diagnostics) *tls.Certificate {
+	certPEM, certPath := readMtlsInput(model, "cert", diagnostics)
+	keyPEM, keyPath := readMtlsInput(model, "key", diagnostics)
+	if diagnostics.HasError() {
+		return nil
+	}
+	keyPEM, err := decryptPrivateKeyPEM(keyPEM, password)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			keyPath,
+			"Unable to decrypt mTLS client key",
+			"Error from: "+err.Error(),
+		)
+		return nil
+	}
+	certificate, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			certPath,
+			"Unable to parse mTLS client certificate",
+			"Error from: "+err.Error(),
+		)
+		return nil
+	}
+	return &certificate
+}
+
+func loadPKCS12Certificate(model *dependencyTrackProviderMtlsModel, source string, password string, diagnostics *diag.Diagnostics) *tls.Certificate {
+	attrPath := path.Root("mtls").AtName(source)
+	var data []byte
+	var err error
+	if source == "pkcs12_path" {
+		data, err = os.ReadFile(model.PKCS12Path.ValueString())
+	} else {
+		data, err = base64.StdEncoding.DecodeString(model.PKCS12Base64.ValueString())
+	}
+	if err != nil {
+		diagnostics.AddAttributeError(
+			attrPath,
+			"Unable to read PKCS#12 bundle",
+			"Error from: "+err.Error(),
+		)
+		return nil
+	}
+	certificate, err := parsePKCS12(data, password)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			attrPath,
+			"Unable to parse PKCS#12 bundle",
+			"Error from: "+err.Error(),
+		)
+		return nil
+	}
+	return &certificate
+}
+
+// Reads '<name>_pem', or the file at '<name>_path', returning the content and the path of the attribute used.
+func readMtlsInput(model *dependencyTrackProviderMtlsModel, name string, diagnostics *diag.Diagnostics) ([]byte, path.Path) {
+	attributes := mtlsAttributes(model)
+	if hasValue(attributes[name+"_pem"]) {
+		return []byte(attributes[name+"_pem"].ValueString()), path.Root("mtls").AtName(name + "_pem")
+	}
+	filePath := path.Root("mtls").AtName(name + "_path")
+	if !hasValue(attributes[name+"_path"]) {
+		diagnostics.AddAttributeError(
+			filePath,
+			"Incomplete mTLS configuration",
+			"Both 'key_path' and 'cert_path', or both 'key_pem' and 'cert_pem', must be provided.",
+		)
+		return nil, filePath
+	}
+	content, err := os.ReadFile(attributes[name+"_path"].ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			filePath,
+			"Unable to read mTLS file",
+			"Error from: "+err.Error(),
+		)
+		return nil, filePath
+	}
+	return content, filePath
+}
+
+func mtlsAttributes(model *dependencyTrackProviderMtlsModel) map[string]types.String {
+	return map[string]types.String{
+		"key_path":      model.KeyPath,
+		"cert_path":     model.CertPath,
+		"key_pem":       model.KeyPEM,
+		"cert_pem":      model.CertPEM,
+		"pkcs12_path":   model.PKCS12Path,
+		"pkcs12_base64": model.PKCS12Base64,
+	}
+}
+
+func hasValue(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
+func loadRootCAs(value types.String, diagnostics *diag.Diagnostics) string {
+	if !value.IsNull() {
+		return value.ValueString()
+	}
+	rootCA := os.Getenv(EnvRootCA)
+	rootCAFile := os.Getenv(EnvRootCAFile)
+	if rootCA != "" && rootCAFile != "" {
+		diagnostics.AddAttributeError(
+			path.Root("root_ca"),
+			"Conflicting Root CA environment configuration",
+			"Only one of '"+EnvRootCA+"' and '"+EnvRootCAFile+"' environment variables may be set.",
+		)
+		return ""
+	}
+	if rootCAFile == "" {
+		return rootCA
+	}
+	pemCerts, err := os.ReadFile(rootCAFile)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("root_ca"),
+			"Unable to read Root CA file",
+			"Unable to read file from '"+EnvRootCAFile+"' environment variable, from: "+err.Error(),
+		)
+		return ""
+	}
+	return string(pemCerts)
+}
+
+func loadTLSOptions(model *dependencyTrackProviderTLSModel, diagnostics *diag.Diagnostics) TLSOptions {
+	options := TLSOptions{
+		ServerName:         "",
+		MinVersion:         tls.VersionTLS13,
+		InsecureSkipVerify: false,
+	}
+	if model == nil {
+		return options
+	}
+	if !model.MinVersion.IsNull() {
+		version, ok := tlsVersions[model.MinVersion.ValueString()]
+		if !ok {
+			diagnostics.AddAttributeError(
+				path.Root("tls").AtName("min_version"),
+				"Invalid TLS version",
+				fmt.Sprintf("Unexpected value of: '%s'. Valid values are: %s.", model.MinVersion.ValueString(), strings.Join(tlsVersionNames(), ", ")),
+			)
+		}
+		options.MinVersion = version
+	}
+	options.ServerName = model.ServerName.ValueString()
+	options.InsecureSkipVerify = model.InsecureSkipVerify.ValueBool()
+	if options.InsecureSkipVerify {
+		diagnostics.AddAttributeWarning(
+			path.Root("tls").AtName("insecure_skip_verify"),
+			"TLS certificate verification is disabled",
+			"The certificate presented by DependencyTrack will not be verified, so the connection is vulnerable to man-in-the-middle attacks. "+
+				"API keys and other credentials may be intercepted. This should only be used for testing.",
+		)
+	}
+	return options
+}
+
+func loadProxyURL(value types.String, diagnostics *diag.Diagnostics) *url.URL {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	proxyURL, err := url.Parse(value.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Invalid proxy URL",
+			"Unable to parse proxy URL, from: "+err.Error(),
+		)
+		return nil
+	}
+	if !slices.Contains([]string{"http", "https", "socks5"}, proxyURL.Scheme) || proxyURL.Host == "" {
+		diagnostics.AddAttributeError(
+			path.Root("proxy_url"),
+			"Invalid proxy URL",
+			fmt.Sprintf("Expected URL with scheme 'http', 'https', or 'socks5', and a host. Found '%s'.", value.ValueString()),
+		)
+		return nil
+	}
+	return proxyURL
+}
+
+func tlsVersionNames() []string {
+	return slices.Sorted(maps.Keys(tlsVersions))
+}
+
+func loadRetryOptions(model *dependencyTrackProviderRetryModel, diagnostics *diag.Diagnostics) RetryOptions {
+	options := RetryOptions{
+		MaxAttempts: DefaultRetryMaxAttempts,
+		MinWait:     DefaultRetryMinWait,
+		MaxWait:     DefaultRetryMaxWait,
+	}
+	if model == nil {
+		return options
+	}
+	if !model.MaxAttempts.IsNull() {
+		options.MaxAttempts = int(model.MaxAttempts.ValueInt32())
+	}
+	options.MinWait = parseDuration(model.MinWait, options.MinWait, path.Root("retry").AtName("min_wait"), diagnostics)
+	options.MaxWait = parseDuration(model.MaxWait, options.MaxWait, path.Root("retry").AtName("max_wait"), diagnostics)
+	if options.MinWait > options.MaxWait {
+		diagnostics.AddAttributeError(
+			path.Root("retry").AtName("min_wait"),
+			"Invalid retry configuration",
+			fmt.Sprintf("'min_wait' of %s must not be greater than 'max_wait' of %s.", options.MinWait, options.MaxWait),
+		)
+	}
+	return options
+}
+
+func parseDuration(value types.String, fallback time.Duration, tfPath path.Path, diagnostics *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		diagnostics.AddAttributeError(
+			tfPath,
+			"Invalid duration",
+			fmt.Sprintf("Unable to parse '%s' as a duration, from: %s", value.ValueString(), err.Error()),
+		)
+		return fallback
+	}
+	if duration < 0 {
+		diagnostics.AddAttributeError(
+			tfPath,
+			"Invalid duration",
+			fmt.Sprintf("Duration must not be negative. Found '%s'.", value.ValueString()),
+		)
+		return fallback
+	}
+	return duration
+}
+
 func getAuthClientOption(config dependencyTrackProviderModel, diagnostics *diag.Diagnostics) dtrack.ClientOption {
 	if !config.Key.IsNull() && !config.Key.IsUnknown() {
 		key := getAPIKey(config.Key, diagnostics)
 		return dtrack.WithAPIKey(key)
 	}
 	if config.Auth == nil {
+		if key := os.Getenv(EnvAPIKey); key != "" {
+			return dtrack.WithAPIKey(key)
+		}
+		if bearer := os.Getenv(EnvBearerToken); bearer != "" {
+			return dtrack.WithBearerToken(strings.TrimPrefix(bearer, "Bearer "))
+		}
 		diagnostics.AddAttributeError(
 			path.Root("auth"),
 			"Missing authentication configuration.",
-			"If 'key' is not provided, then 'auth' block is required.",
+			"If 'key' is not provided, then 'auth' block is required, "+
+				"unless '"+EnvAPIKey+"' or '"+EnvBearerToken+"' environment variable is set.",
 		)
 		return nopClientOption
 	}
@@ -337,10 +960,21 @@ func getAuthClientOption(config dependencyTrackProviderModel, diagnostics *diag.
 		}
 	case "BEARER":
 		{
-			bearer := config.Auth.Bearer.ValueString()
+			bearer := stringFromConfigOrEnv(config.Auth.Bearer, EnvBearerToken)
 			bearer = strings.TrimPrefix(bearer, "Bearer ")
+			if bearer == "" {
+				diagnostics.AddAttributeError(
+					path.Root("auth").AtName("bearer"),
+					"Missing DependencyTrack Bearer Token",
+					"Bearer token must be provided, either using the 'auth.bearer' attribute, or the '"+EnvBearerToken+"' environment variable.",
+				)
+				return nopClientOption
+			}
 			return dtrack.WithBearerToken(bearer)
 		}
+	case "CREDENTIALS", "OIDC_CLIENT_CREDENTIALS":
+		// Authentication is added to the HTTP Client within configureTokenAuth.
+		return nopClientOption
 	default:
 		{
 			diagnostics.AddAttributeError(
@@ -353,11 +987,135 @@ func getAuthClientOption(config dependencyTrackProviderModel, diagnostics *diag.
 	}
 }
 
+// Wraps the HTTP Client to authenticate using tokens which are fetched, and refreshed, by the provider.
+func configureTokenAuth(ctx context.Context, host string, auth *providerAuthModel, httpClient *http.Client, diagnostics *diag.Diagnostics) {
+	if auth == nil {
+		return
+	}
+	var fetch TokenFetchFunc
+	switch auth.Type.ValueString() {
+	case "CREDENTIALS":
+		fetch = newCredentialsTokenFetch(host, auth, httpClient, diagnostics)
+	case "OIDC_CLIENT_CREDENTIALS":
+		fetch = newOIDCTokenFetch(ctx, auth, httpClient, diagnostics)
+	default:
+		return
+	}
+	if diagnostics.HasError() {
+		return
+	}
+	tokenAuth := newTokenTransport(httpClient.Transport, fetch)
+	// Fetch initial token, to report invalid credentials during configure.
+	_, err := tokenAuth.currentToken(ctx)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("auth"),
+			"Unable to authenticate with DependencyTrack",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	httpClient.Transport = tokenAuth
+}
+
+func newCredentialsTokenFetch(host string, auth *providerAuthModel, httpClient *http.Client, diagnostics *diag.Diagnostics) TokenFetchFunc {
+	username := stringFromConfigOrEnv(auth.Username, EnvUsername)
+	if username == "" {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("username"),
+			"Missing DependencyTrack Username",
+			"Username must be provided, either using the 'auth.username' attribute, or the '"+EnvUsername+"' environment variable.",
+		)
+	}
+	password := stringFromConfigOrEnv(auth.Password, EnvPassword)
+	if password == "" {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("password"),
+			"Missing DependencyTrack Password",
+			"Password must be provided, either using the 'auth.password' attribute, or the '"+EnvPassword+"' environment variable.",
+		)
+	}
+	if diagnostics.HasError() {
+		return nil
+	}
+	// Login client uses a copy of the HTTP Client, so that it does not authenticate itself.
+	loginHTTPClient := *httpClient
+	loginClient, err := dtrack.NewClient(host, dtrack.WithHttpClient(&loginHTTPClient))
+	if err != nil {
+		diagnostics.AddError(
+			"Unable to Create DependencyTrack API Client for login",
+			"Error from: "+err.Error(),
+		)
+		return nil
+	}
+	return func(ctx context.Context) (string, time.Time, error) {
+		token, loginErr := loginClient.User.Login(ctx, username, password)
+		if loginErr != nil {
+			return "", time.Time{}, errors.New("unable to login as user '" + username + "', from: " + loginErr.Error())
+		}
+		return token, jwtExpiry(token), nil
+	}
+}
+
+func newOIDCTokenFetch(ctx context.Context, auth *providerAuthModel, httpClient *http.Client, diagnostics *diag.Diagnostics) TokenFetchFunc {
+	tokenURL := stringFromConfigOrEnv(auth.TokenURL, EnvOIDCTokenURL)
+	if tokenURL == "" {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("token_url"),
+			"Missing OIDC Token URL",
+			"Token URL must be provided, either using the 'auth.token_url' attribute, or the '"+EnvOIDCTokenURL+"' environment variable.",
+		)
+	} else if parsed, err := url.Parse(tokenURL); err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("token_url"),
+			"Invalid OIDC Token URL",
+			fmt.Sprintf("Expected an absolute http(s) URL. Found '%s'.", tokenURL),
+		)
+	}
+	clientID := stringFromConfigOrEnv(auth.ClientID, EnvOIDCClientID)
+	if clientID == "" {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("client_id"),
+			"Missing OIDC Client ID",
+			"Client ID must be provided, either using the 'auth.client_id' attribute, or the '"+EnvOIDCClientID+"' environment variable.",
+		)
+	}
+	clientSecret := stringFromConfigOrEnv(auth.ClientSecret, EnvOIDCSecret)
+	if clientSecret == "" {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("client_secret"),
+			"Missing OIDC Client Secret",
+			"Client Secret must be provided, either using the 'auth.client_secret' attribute, or the '"+EnvOIDCSecret+"' environment variable.",
+		)
+	}
+	scopes, err := GetStringList(ctx, diagnostics, auth.Scopes)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root("auth").AtName("scopes"),
+			"Unable to load OIDC scopes",
+			"Error from: "+err.Error(),
+		)
+	}
+	if diagnostics.HasError() {
+		return nil
+	}
+	// Token endpoint is not DependencyTrack, so must not receive its headers, nor authenticate itself.
+	tokenHTTPClient, err := newThirdPartyHTTPClient(httpClient)
+	if err != nil {
+		diagnostics.AddError(
+			"Unable to Create HTTP Client for OIDC Token URL",
+			"Error from: "+err.Error(),
+		)
+		return nil
+	}
+	return newClientCredentialsTokenFetch(tokenHTTPClient, tokenURL, clientID, clientSecret, scopes)
+}
+
 func getAPIKey(value types.String, diagnostics *diag.Diagnostics) string {
 	key := value.ValueString()
 	// If key is the magic value 'OS_ENV', load from environment variable.
 	if key == "OS_ENV" {
-		key = os.Getenv("DEPENDENCYTRACK_API_KEY")
+		key = os.Getenv(EnvAPIKey)
 	}
 	if key == "" {
 		diagnostics.AddAttributeError(
@@ -370,6 +1128,13 @@ func getAPIKey(value types.String, diagnostics *diag.Diagnostics) string {
 	return key
 }
 
+func stringFromConfigOrEnv(value types.String, envName string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envName)
+}
+
 func nopClientOption(_ *dtrack.Client) error {
 	return nil
 }
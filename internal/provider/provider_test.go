@@ -1,11 +1,21 @@
 package provider
 
 import (
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
+	"testing"
+	"time"
 
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
 )
 
 var (
@@ -53,6 +63,14 @@ var (
 				}
 			}`
 		}
+		if option == "credentials" {
+			return `provider "dependencytrack" {
+				host = "http://localhost:8081"
+				auth = {
+					type = "CREDENTIALS"
+				}
+			}`
+		}
 		if option == "v5" {
 			return `provider "dependencytrack" {
 				host = "http://localhost:9081"
@@ -65,7 +83,220 @@ var (
 		}`
 	}()
 
+	// Adds attributes to the provider block within providerConfig.
+	providerConfigWith = func(attributes string) string {
+		end := strings.LastIndex(providerConfig, "}")
+		return providerConfig[:end] + attributes + "\n}"
+	}
+
 	testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
 		"dependencytrack": providerserver.NewProtocol6WithError(New("test")()),
 	}
+
+	// Includes the echo provider, to expose results of ephemeral resources to checks.
+	testAccProtoV6ProviderFactoriesWithEcho = map[string]func() (tfprotov6.ProviderServer, error){
+		"dependencytrack": providerserver.NewProtocol6WithError(New("test")()),
+		"echo":            echoprovider.NewProviderServer(),
+	}
 )
+
+// Client to change DependencyTrack outside of Terraform within acceptance tests.
+// Only configurations of providerConfig authenticating with an API Key over HTTP are supported, so others are skipped.
+func testAccClient(t *testing.T) *dtrack.Client {
+	t.Helper()
+	option := os.Getenv("DEPENDENCYTRACK_TEST_PROVIDER")
+	host, ok := map[string]string{
+		"":   "http://localhost:8081",
+		"v5": "http://localhost:9081",
+	}[option]
+	if !ok {
+		t.Skip("Changes outside of Terraform are not supported with DEPENDENCYTRACK_TEST_PROVIDER=" + option)
+	}
+	client, err := dtrack.NewClient(host, dtrack.WithAPIKey(os.Getenv(EnvAPIKey)))
+	requireNoError(t, err)
+	return client
+}
+
+func TestStringFromConfigOrEnv(t *testing.T) {
+	t.Setenv(EnvHost, "http://env:8081")
+	requireEqual(t, stringFromConfigOrEnv(types.StringValue("http://config:8081"), EnvHost), "http://config:8081")
+	requireEqual(t, stringFromConfigOrEnv(types.StringNull(), EnvHost), "http://env:8081")
+	t.Setenv(EnvHost, "")
+	requireEqual(t, stringFromConfigOrEnv(types.StringNull(), EnvHost), "")
+}
+
+func TestLoadEnvHeaders(t *testing.T) {
+	{
+		diags := diag.Diagnostics{}
+		headers := loadEnvHeaders("", &diags)
+		requireEqual(t, diags.HasError(), false)
+		requireEqual(t, len(headers), 0)
+	}
+	{
+		diags := diag.Diagnostics{}
+		headers := loadEnvHeaders(`{"X-B": "2", "X-A": "1"}`, &diags)
+		requireEqual(t, diags.HasError(), false)
+		requireEqual(t, len(headers), 2)
+		requireEqual(t, headers[0].Name, "X-A")
+		requireEqual(t, headers[0].Value, "1")
+		requireEqual(t, headers[1].Name, "X-B")
+		requireEqual(t, headers[1].Value, "2")
+	}
+	{
+		diags := diag.Diagnostics{}
+		loadEnvHeaders(`X-A=1`, &diags)
+		requireEqual(t, diags.HasError(), true)
+	}
+	{
+		diags := diag.Diagnostics{}
+		loadEnvHeaders(`{"X-A": ""}`, &diags)
+		requireEqual(t, diags.HasError(), true)
+	}
+}
+
+func TestLoadRootCAs(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	requireNoError(t, os.WriteFile(file, []byte("FILE_PEM"), 0o600))
+	{
+		t.Setenv(EnvRootCA, "ENV_PEM")
+		t.Setenv(EnvRootCAFile, "")
+		diags := diag.Diagnostics{}
+		requireEqual(t, loadRootCAs(types.StringValue("CONFIG_PEM"), &diags), "CONFIG_PEM")
+		requireEqual(t, loadRootCAs(types.StringNull(), &diags), "ENV_PEM")
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		t.Setenv(EnvRootCA, "")
+		t.Setenv(EnvRootCAFile, file)
+		diags := diag.Diagnostics{}
+		requireEqual(t, loadRootCAs(types.StringNull(), &diags), "FILE_PEM")
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		t.Setenv(EnvRootCA, "ENV_PEM")
+		t.Setenv(EnvRootCAFile, file)
+		diags := diag.Diagnostics{}
+		loadRootCAs(types.StringNull(), &diags)
+		requireEqual(t, diags.HasError(), true)
+	}
+}
+
+func TestLoadClientCertificate(t *testing.T) {
+	certPEM, err := os.ReadFile("testdata/mtls/cert.pem")
+	requireNoError(t, err)
+	keyPEM, err := os.ReadFile("testdata/mtls/key_encrypted.pem")
+	requireNoError(t, err)
+	bundle, err := os.ReadFile("testdata/mtls/bundle.p12")
+	requireNoError(t, err)
+	newModel := func() *dependencyTrackProviderMtlsModel {
+		return &dependencyTrackProviderMtlsModel{
+			KeyPath:      types.StringNull(),
+			CertPath:     types.StringNull(),
+			KeyPEM:       types.StringNull(),
+			CertPEM:      types.StringNull(),
+			KeyPassword:  types.StringValue("test-password"),
+			PKCS12Path:   types.StringNull(),
+			PKCS12Base64: types.StringNull(),
+		}
+	}
+	t.Setenv(EnvClientCertFile, "")
+	t.Setenv(EnvClientKeyFile, "")
+	{
+		diags := diag.Diagnostics{}
+		requireNil(t, loadClientCertificate(nil, &diags))
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		model := newModel()
+		model.CertPEM = types.StringValue(string(certPEM))
+		model.KeyPEM = types.StringValue(string(keyPEM))
+		diags := diag.Diagnostics{}
+		requireEqual(t, loadClientCertificate(model, &diags) != nil, true)
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		model := newModel()
+		model.CertPath = types.StringValue("testdata/mtls/cert.pem")
+		model.KeyPEM = types.StringValue(string(keyPEM))
+		diags := diag.Diagnostics{}
+		requireNil(t, loadClientCertificate(model, &diags))
+		requireEqual(t, diags.HasError(), true)
+	}
+	{
+		model := newModel()
+		model.CertPath = types.StringValue("testdata/mtls/cert.pem")
+		diags := diag.Diagnostics{}
+		requireNil(t, loadClientCertificate(model, &diags))
+		requireEqual(t, diags.HasError(), true)
+	}
+	{
+		model := newModel()
+		model.PKCS12Base64 = types.StringValue(base64.StdEncoding.EncodeToString(bundle))
+		diags := diag.Diagnostics{}
+		requireEqual(t, loadClientCertificate(model, &diags) != nil, true)
+		requireEqual(t, diags.HasError(), false)
+	}
+	{
+		model := newModel()
+		model.PKCS12Path = types.StringValue("testdata/mtls/bundle.p12")
+		model.CertPEM = types.StringValue(string(certPEM))
+		diags := diag.Diagnostics{}
+		requireNil(t, loadClientCertificate(model, &diags))
+		requireEqual(t, diags.HasError(), true)
+	}
+	{
+		model := newModel()
+		model.CertPEM = types.StringValue(string(certPEM))
+		model.KeyPEM = types.StringValue(string(keyPEM))
+		model.KeyPassword = types.StringValue("wrong-password")
+		diags := diag.Diagnostics{}
+		requireNil(t, loadClientCertificate(model, &diags))
+		requireEqual(t, diags.HasError(), true)
+		withPath, ok := diags.Errors()[0].(diag.DiagnosticWithPath)
+		requireEqual(t, ok, true)
+		requireEqual(t, withPath.Path().String(), "mtls.key_pem")
+	}
+	{
+		t.Setenv(EnvClientCertFile, "testdata/mtls/cert.pem")
+		t.Setenv(EnvClientKeyFile, "testdata/mtls/key_encrypted.pem")
+		t.Setenv(EnvClientKeyPassword, "test-password")
+		diags := diag.Diagnostics{}
+		requireEqual(t, loadClientCertificate(nil, &diags) != nil, true)
+		requireEqual(t, diags.HasError(), false)
+	}
+}
+
+func TestOIDCTokenFetchOmitsHeaders(t *testing.T) {
+	var received http.Header
+	idp := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"idp-token","token_type":"Bearer","expires_in":3600}`))
+	}))
+	defer idp.Close()
+	httpClient, err := NewHTTPClient(HTTPClientOptions{
+		Headers: []Header{{Name: "X-Proxy-Authorization", Value: "dtrack-secret"}},
+		Retry:   RetryOptions{MaxAttempts: DefaultRetryMaxAttempts, MinWait: time.Millisecond, MaxWait: time.Millisecond},
+		Timeout: time.Minute,
+	})
+	requireNoError(t, err)
+	diags := diag.Diagnostics{}
+	fetch := newOIDCTokenFetch(t.Context(), &providerAuthModel{
+		Type:         types.StringValue("OIDC_CLIENT_CREDENTIALS"),
+		Key:          types.StringNull(),
+		Bearer:       types.StringNull(),
+		Username:     types.StringNull(),
+		Password:     types.StringNull(),
+		TokenURL:     types.StringValue(idp.URL),
+		ClientID:     types.StringValue("client"),
+		ClientSecret: types.StringValue("secret"),
+		Scopes:       types.ListNull(types.StringType),
+	}, httpClient, &diags)
+	requireEqual(t, diags.HasError(), false)
+
+	token, _, err := fetch(t.Context())
+	requireNoError(t, err)
+	requireEqual(t, token, "idp-token")
+	requireEqual(t, received.Get("X-Proxy-Authorization"), "")
+	requireEqual(t, received.Get("Authorization") != "", true)
+}
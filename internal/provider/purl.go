@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+type (
+	// Package URL, as specified by https://github.com/package-url/purl-spec.
+	packageURL struct {
+		Type       string
+		Namespace  string
+		Name       string
+		Version    string
+		Qualifiers map[string]string
+		Subpath    string
+	}
+)
+
+var (
+	purlTypePattern         = regexp.MustCompile(`^[a-z][a-z0-9.+-]*$`)
+	purlQualifierKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9.+_-]*$`)
+)
+
+// Parses a Package URL, normalising it as the spec defines, so that String returns the canonical form.
+func parsePackageURL(purl string) (packageURL, error) {
+	result := packageURL{Type: "", Namespace: "", Name: "", Version: "", Qualifiers: map[string]string{}, Subpath: ""}
+	remainder := strings.TrimSpace(purl)
+
+	if index := strings.LastIndex(remainder, "#"); index >= 0 {
+		subpath, err := decodePURLSegments(remainder[index+1:], true)
+		if err != nil {
+			return result, fmt.Errorf("invalid subpath: %w", err)
+		}
+		result.Subpath = subpath
+		remainder = remainder[:index]
+	}
+	if index := strings.LastIndex(remainder, "?"); index >= 0 {
+		qualifiers, err := decodePURLQualifiers(remainder[index+1:])
+		if err != nil {
+			return result, err
+		}
+		result.Qualifiers = qualifiers
+		remainder = remainder[:index]
+	}
+
+	scheme, remainder, ok := strings.Cut(remainder, ":")
+	if !ok || !strings.EqualFold(scheme, "pkg") {
+		return result, errors.New("expected scheme 'pkg:'")
+	}
+	remainder = strings.Trim(remainder, "/")
+	purlType, remainder, ok := strings.Cut(remainder, "/")
+	if !ok {
+		return result, errors.New("expected '<type>/<name>' after scheme")
+	}
+	result.Type = strings.ToLower(purlType)
+
+	if index := strings.LastIndex(remainder, "@"); index >= 0 {
+		version, err := url.PathUnescape(remainder[index+1:])
+		if err != nil {
+			return result, fmt.Errorf("invalid version: %w", err)
+		}
+		result.Version = version
+		remainder = remainder[:index]
+	}
+	remainder = strings.TrimRight(remainder, "/")
+	if index := strings.LastIndex(remainder, "/"); index >= 0 {
+		namespace, err := decodePURLSegments(remainder[:index], false)
+		if err != nil {
+			return result, fmt.Errorf("invalid namespace: %w", err)
+		}
+		result.Namespace = namespace
+		remainder = remainder[index+1:]
+	}
+	name, err := url.PathUnescape(remainder)
+	if err != nil {
+		return result, fmt.Errorf("invalid name: %w", err)
+	}
+	result.Name = name
+
+	return result.normalize()
+}
+
+// Validates components, and applies the normalisation rules of known types.
+func (p packageURL) normalize() (packageURL, error) {
+	p.Type = strings.ToLower(p.Type)
+	if !purlTypePattern.MatchString(p.Type) {
+		return p, fmt.Errorf("invalid type '%s', must start with a letter, and contain only letters, numbers, '.', '+' or '-'", p.Type)
+	}
+	p.Namespace = strings.Trim(p.Namespace, "/")
+	p.Subpath = strings.Trim(p.Subpath, "/")
+	if p.Name == "" {
+		return p, errors.New("name is required")
+	}
+	switch p.Type {
+	case "bitbucket", "github", "composer":
+		p.Namespace = strings.ToLower(p.Namespace)
+		p.Name = strings.ToLower(p.Name)
+	case "pypi":
+		p.Name = strings.ReplaceAll(strings.ToLower(p.Name), "_", "-")
+	case "maven":
+		if p.Namespace == "" {
+			return p, errors.New("namespace is required for type 'maven', as the group id")
+		}
+	}
+	qualifiers := make(map[string]string, len(p.Qualifiers))
+	for key, value := range p.Qualifiers {
+		key = strings.ToLower(key)
+		if !purlQualifierKeyPattern.MatchString(key) {
+			return p, fmt.Errorf("invalid qualifier key '%s'", key)
+		}
+		if _, ok := qualifiers[key]; ok {
+			return p, fmt.Errorf("duplicate qualifier key '%s'", key)
+		}
+		if value != "" {
+			qualifiers[key] = value
+		}
+	}
+	p.Qualifiers = qualifiers
+	return p, nil
+}
+
+// String returns the canonical form of the Package URL.
+func (p packageURL) String() string {
+	var builder strings.Builder
+	builder.WriteString("pkg:" + p.Type + "/")
+	if p.Namespace != "" {
+		builder.WriteString(encodePURLSegments(p.Namespace) + "/")
+	}
+	builder.WriteString(escapePURL(p.Name, false))
+	if p.Version != "" {
+		builder.WriteString("@" + escapePURL(p.Version, false))
+	}
+	if len(p.Qualifiers) > 0 {
+		pairs := Map(slices.Sorted(maps.Keys(p.Qualifiers)), func(key string) string {
+			return key + "=" + escapePURL(p.Qualifiers[key], true)
+		})
+		builder.WriteString("?" + strings.Join(pairs, "&"))
+	}
+	if p.Subpath != "" {
+		builder.WriteString("#" + encodePURLSegments(p.Subpath))
+	}
+	return builder.String()
+}
+
+// Decodes '/' separated segments, discarding empty segments, and for a subpath, '.' and '..' segments.
+func decodePURLSegments(value string, subpath bool) (string, error) {
+	segments := []string{}
+	for segment := range strings.SplitSeq(value, "/") {
+		decoded, err := url.PathUnescape(segment)
+		if err != nil {
+			return "", err
+		}
+		if decoded == "" || (subpath && (decoded == "." || decoded == "..")) {
+			continue
+		}
+		segments = append(segments, decoded)
+	}
+	return strings.Join(segments, "/"), nil
+}
+
+func decodePURLQualifiers(value string) (map[string]string, error) {
+	qualifiers := map[string]string{}
+	for pair := range strings.SplitSeq(value, "&") {
+		if pair == "" {
+			continue
+		}
+		key, encoded, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid qualifier '%s', expected '<key>=<value>'", pair)
+		}
+		key = strings.ToLower(key)
+		if _, ok := qualifiers[key]; ok {
+			return nil, fmt.Errorf("duplicate qualifier key '%s'", key)
+		}
+		decoded, err := url.PathUnescape(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of qualifier '%s': %w", key, err)
+		}
+		qualifiers[key] = decoded
+	}
+	return qualifiers, nil
+}
+
+func encodePURLSegments(value string) string {
+	return strings.Join(Map(strings.Split(value, "/"), func(segment string) string {
+		return escapePURL(segment, false)
+	}), "/")
+}
+
+// Percent-encodes all but unreserved characters and ':', and '/' within qualifier values.
+func escapePURL(value string, qualifier bool) string {
+	var builder strings.Builder
+	for _, b := range []byte(value) {
+		switch {
+		case 'a' <= b && b <= 'z', 'A' <= b && b <= 'Z', '0' <= b && b <= '9',
+			b == '-', b == '.', b == '_', b == '~', b == ':', qualifier && b == '/':
+			builder.WriteByte(b)
+		default:
+			fmt.Fprintf(&builder, "%%%02X", b)
+		}
+	}
+	return builder.String()
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParsePackageURL(t *testing.T) {
+	{
+		purl, err := parsePackageURL("pkg:maven/org.apache.logging.log4j/log4j-core@2.17.0?type=jar&classifier=sources")
+		requireNoError(t, err)
+		requireEqual(t, purl.Type, "maven")
+		requireEqual(t, purl.Namespace, "org.apache.logging.log4j")
+		requireEqual(t, purl.Name, "log4j-core")
+		requireEqual(t, purl.Version, "2.17.0")
+		requireEqual(t, len(purl.Qualifiers), 2)
+		requireEqual(t, purl.Qualifiers["type"], "jar")
+		requireEqual(t, purl.Subpath, "")
+		requireEqual(t, purl.String(), "pkg:maven/org.apache.logging.log4j/log4j-core@2.17.0?classifier=sources&type=jar")
+	}
+	{
+		purl, err := parsePackageURL("PKG:GitHub/Package-URL/Purl-Spec@244fd47e07d1004#everybody/loves/../dogs")
+		requireNoError(t, err)
+		requireEqual(t, purl.Type, "github")
+		requireEqual(t, purl.Namespace, "package-url")
+		requireEqual(t, purl.Name, "purl-spec")
+		requireEqual(t, purl.Subpath, "everybody/loves/dogs")
+		requireEqual(t, purl.String(), "pkg:github/package-url/purl-spec@244fd47e07d1004#everybody/loves/dogs")
+	}
+	{
+		purl, err := parsePackageURL("pkg:npm/%40angular/core@16.0.0")
+		requireNoError(t, err)
+		requireEqual(t, purl.Namespace, "@angular")
+		requireEqual(t, purl.String(), "pkg:npm/%40angular/core@16.0.0")
+	}
+	{
+		purl, err := parsePackageURL("pkg:pypi/Django_Rest@1.0?repository_url=https://example.com/simple&empty=")
+		requireNoError(t, err)
+		requireEqual(t, purl.Name, "django-rest")
+		requireEqual(t, len(purl.Qualifiers), 1)
+		requireEqual(t, purl.String(), "pkg:pypi/django-rest@1.0?repository_url=https://example.com/simple")
+	}
+	{
+		_, err := parsePackageURL("maven/org.example/artifact")
+		requireError(t, err, "^expected scheme 'pkg:'$")
+	}
+	{
+		_, err := parsePackageURL("pkg:maven/artifact@1.0")
+		requireError(t, err, "^namespace is required for type 'maven', as the group id$")
+	}
+	{
+		_, err := parsePackageURL("pkg:npm")
+		requireError(t, err, "^expected '<type>/<name>' after scheme$")
+	}
+	{
+		_, err := parsePackageURL("pkg:1npm/name")
+		requireError(t, err, "^invalid type '1npm'")
+	}
+	{
+		_, err := parsePackageURL("pkg:npm/name?a=1&A=2")
+		requireError(t, err, "^duplicate qualifier key 'a'$")
+	}
+}
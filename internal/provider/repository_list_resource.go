@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &repositoryListResource{}
+	_ list.ListResourceWithConfigure = &repositoryListResource{}
+)
+
+type repositoryListResource struct {
+	managed *repositoryResource
+}
+
+func NewRepositoryListResource() list.ListResource {
+	return &repositoryListResource{managed: &repositoryResource{}}
+}
+
+func (*repositoryListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (*repositoryListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Repositories, for `terraform query`.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+}
+
+func (r *repositoryListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing Repositories")
+	repositories, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error) {
+		return r.managed.client.Repository.GetAll(ctx, po)
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Within List, unable to list repositories.",
+			"Error from: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tflog.Debug(ctx, "Listed Repositories", map[string]any{
+		"count": len(repositories),
+	})
+	streamListResults(ctx, req, stream, r.managed, repositoryIdentity, repositories, func(repository dtrack.Repository) (string, string) {
+		return repository.UUID.String(), string(repository.Type) + "/" + repository.Identifier
+	})
+}
+
+func (r *repositoryListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.managed.Configure(ctx, req, resp)
+}
@@ -2,14 +2,20 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +24,7 @@ var (
 	_ resource.Resource                = &repositoryResource{}
 	_ resource.ResourceWithConfigure   = &repositoryResource{}
 	_ resource.ResourceWithImportState = &repositoryResource{}
+	_ resource.ResourceWithIdentity    = &repositoryResource{}
 )
 
 type (
@@ -27,15 +34,17 @@ type (
 	}
 
 	repositoryResourceModel struct {
-		ID         types.String `tfsdk:"id"`
-		Type       types.String `tfsdk:"type"`
-		Identifier types.String `tfsdk:"identifier"`
-		URL        types.String `tfsdk:"url"`
-		Username   types.String `tfsdk:"username"`
-		Password   types.String `tfsdk:"password"`
-		Precedence types.Int32  `tfsdk:"precedence"`
-		Enabled    types.Bool   `tfsdk:"enabled"`
-		Internal   types.Bool   `tfsdk:"internal"`
+		ID                types.String `tfsdk:"id"`
+		Type              types.String `tfsdk:"type"`
+		Identifier        types.String `tfsdk:"identifier"`
+		URL               types.String `tfsdk:"url"`
+		Username          types.String `tfsdk:"username"`
+		Password          types.String `tfsdk:"password"`
+		PasswordWO        types.String `tfsdk:"password_wo"`
+		PasswordWOVersion types.Int32  `tfsdk:"password_wo_version"`
+		Precedence        types.Int32  `tfsdk:"precedence"`
+		Enabled           types.Bool   `tfsdk:"enabled"`
+		Internal          types.Bool   `tfsdk:"internal"`
 	}
 )
 
@@ -59,12 +68,13 @@ func (*repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"type": schema.StringAttribute{
-				Description: "Type of the Repository. See DependencyTrack for valid enum values.",
+				Description: "Type of the Repository. Supports " + describeValues(repositoryTypeValues) + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{stringvalidator.OneOf(repositoryTypeValues...)},
 			},
 			"identifier": schema.StringAttribute{
 				Description: "Identifier of the Repository.",
@@ -96,14 +106,36 @@ func (*repositoryResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Password to use for Authentication to Repository.",
-				Required:    true,
+				Description: "Password to use for Authentication to Repository. Stored in state, prefer `password_wo`.",
+				Optional:    true,
 				Sensitive:   true,
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Password to use for Authentication to Repository, which is never stored in state or plan. " +
+					"Only sent on create, or when `password_wo_version` changes. Requires Terraform 1.11+.",
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int32Attribute{
+				Description: "Version of `password_wo`. Change to send the current value of `password_wo` to the Repository.",
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.AlsoRequires(path.MatchRoot("password_wo"))},
+			},
 		},
 	}
 }
 
+var repositoryIdentity = uuidIdentity("UUID of the Repository.")
+
+func (*repositoryResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = repositoryIdentity.Schema()
+}
+
 func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan repositoryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -112,6 +144,13 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	password := plan.Password.ValueString()
+	if !plan.PasswordWOVersion.IsNull() {
+		password = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "password_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	repositoryReq := dtrack.Repository{
 		Type:                   dtrack.RepositoryType(plan.Type.ValueString()),
 		Identifier:             plan.Identifier.ValueString(),
@@ -120,8 +159,8 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		Enabled:                plan.Enabled.ValueBool(),
 		Internal:               plan.Internal.ValueBool(),
 		Username:               plan.Username.ValueString(),
-		Password:               plan.Password.ValueString(),
-		AuthenticationRequired: plan.Password.ValueString() != "" || plan.Username.ValueString() != "",
+		Password:               password,
+		AuthenticationRequired: password != "" || plan.Username.ValueString() != "",
 	}
 
 	tflog.Debug(ctx, "Creating a Repository", map[string]any{
@@ -152,7 +191,9 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		Internal:   types.BoolValue(repositoryRes.Internal),
 		Username:   types.StringValue(repositoryRes.Username),
 		// API Response does not include Password.
-		Password: plan.Password,
+		Password:          plan.Password,
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: plan.PasswordWOVersion,
 	}
 
 	diags = resp.State.Set(ctx, plan)
@@ -160,6 +201,7 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(repositoryIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Repository", map[string]any{
 		"id":         plan.ID.ValueString(),
 		"type":       plan.Type.ValueString(),
@@ -173,6 +215,7 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(repositoryIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state repositoryResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -202,7 +245,9 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 			return repo.UUID == id
 		},
 	)
-
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated repository",
@@ -221,7 +266,9 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 		Internal:   types.BoolValue(repository.Internal),
 		Username:   types.StringValue(repository.Username),
 		// API Response does not include Password.
-		Password: state.Password,
+		Password:          state.Password,
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: state.PasswordWOVersion,
 	}
 
 	// Update state.
@@ -245,8 +292,11 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get State.
 	var plan repositoryResourceModel
+	var state repositoryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -257,6 +307,14 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		resp.Diagnostics.Append(diag)
 		return
 	}
+	// When omitted, DependencyTrack retains the current Password.
+	password := plan.Password.ValueString()
+	if !plan.PasswordWOVersion.IsNull() && !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		password = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "password_wo")
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	repositoryReq := dtrack.Repository{
 		UUID:                   id,
 		Type:                   dtrack.RepositoryType(plan.Type.ValueString()),
@@ -266,8 +324,8 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		Enabled:                plan.Enabled.ValueBool(),
 		Internal:               plan.Internal.ValueBool(),
 		Username:               plan.Username.ValueString(),
-		Password:               plan.Password.ValueString(),
-		AuthenticationRequired: plan.Password.ValueString() != "" || plan.Username.ValueString() != "",
+		Password:               password,
+		AuthenticationRequired: !plan.PasswordWOVersion.IsNull() || password != "" || plan.Username.ValueString() != "",
 	}
 
 	// Execute.
@@ -301,7 +359,9 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 		Internal:   types.BoolValue(repositoryRes.Internal),
 		Username:   types.StringValue(repositoryRes.Username),
 		// API Response does not include Password.
-		Password: plan.Password,
+		Password:          plan.Password,
+		PasswordWO:        types.StringNull(),
+		PasswordWOVersion: plan.PasswordWOVersion,
 	}
 
 	// Update State.
@@ -310,6 +370,7 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(repositoryIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Repository", map[string]any{
 		"id":         plan.ID.ValueString(),
 		"type":       plan.Type.ValueString(),
@@ -362,16 +423,38 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 	})
 }
 
-func (*repositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *repositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := repositoryIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing Repository", map[string]any{
-		"id": req.ID,
+		"id": importID,
+	})
+	id, err := ResolveImportID(importID, "<Type>/<Identifier>", func(key string) ([]uuid.UUID, error) {
+		repoType, identifier, ok := strings.Cut(key, "/")
+		if !ok || repoType == "" || identifier == "" {
+			return nil, errors.New("expected id in format <Type>/<Identifier>")
+		}
+		repositories, err := FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Repository], error) {
+			return r.client.Repository.GetByType(ctx, dtrack.RepositoryType(strings.ToUpper(repoType)), po)
+		}, func(repository dtrack.Repository) bool { return repository.Identifier == identifier })
+		return Map(repositories, func(repository dtrack.Repository) uuid.UUID { return repository.UUID }), err
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve repository.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported Repository", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
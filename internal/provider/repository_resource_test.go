@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccRepositoryResource(t *testing.T) {
@@ -47,6 +48,15 @@ resource "dependencytrack_repository" "test" {
 					"password",
 				},
 			},
+			{
+				ResourceName:      "dependencytrack_repository.test",
+				ImportState:       true,
+				ImportStateId:     "GITHUB/Test_Repository",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password",
+				},
+			},
 			// Update and Read testing.
 			{
 				Config: providerConfig + `
@@ -76,3 +86,53 @@ resource "dependencytrack_repository" "test" {
 		},
 	})
 }
+
+func TestAccRepositoryResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_repository" "test" {
+	type = "GITHUB"
+	identifier = "Test_Repository_WriteOnly"
+	url = "https://localhost"
+	enabled = true
+	internal = false
+	username = "Test_Username"
+	password_wo = "Test_Password"
+	password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dependencytrack_repository.test", "id"),
+					resource.TestCheckNoResourceAttr("dependencytrack_repository.test", "password"),
+					resource.TestCheckNoResourceAttr("dependencytrack_repository.test", "password_wo"),
+					resource.TestCheckResourceAttr("dependencytrack_repository.test", "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_repository" "test" {
+	type = "GITHUB"
+	identifier = "Test_Repository_WriteOnly"
+	url = "https://localhost"
+	enabled = false
+	internal = false
+	username = "Test_Username"
+	password_wo = "Test_Password_With_Change"
+	password_wo_version = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_repository.test", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("dependencytrack_repository.test", "password_wo"),
+					resource.TestCheckResourceAttr("dependencytrack_repository.test", "password_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
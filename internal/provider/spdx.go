@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	SPDXOperatorAnd  = "AND"
+	SPDXOperatorOr   = "OR"
+	SPDXOperatorWith = "WITH"
+)
+
+type (
+	// Node of a parsed SPDX license expression. Either a license, or an operator with operands.
+	spdxExpression struct {
+		// Empty for a license or exception.
+		Operator string
+		License  string
+		Operands []spdxExpression
+	}
+
+	spdxToken struct {
+		Value    string
+		Position int
+	}
+
+	spdxParser struct {
+		tokens []spdxToken
+		index  int
+		length int
+	}
+)
+
+var (
+	spdxLicensePattern   = regexp.MustCompile(`^(DocumentRef-[A-Za-z0-9.-]+:)?[A-Za-z0-9.-]+\+?$`)
+	spdxExceptionPattern = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+)
+
+// Parses and normalises an SPDX license expression, as specified by SPDX 2.3 Annex D.
+// Operators are upper-cased, whitespace collapsed, redundant parentheses removed, and nested operators of the same
+// kind flattened. The order of operands, and the case of license identifiers, are retained.
+func normalizeSPDXExpression(expression string) (string, error) {
+	tokens := tokenizeSPDX(expression)
+	if len(tokens) == 0 {
+		return "", errors.New("expression is empty")
+	}
+	parser := spdxParser{tokens: tokens, index: 0, length: len(expression)}
+	parsed, err := parser.parseOr()
+	if err != nil {
+		return "", err
+	}
+	if token, ok := parser.peek(); ok {
+		return "", fmt.Errorf("unexpected '%s' at position %d", token.Value, token.Position)
+	}
+	return parsed.String(), nil
+}
+
+func tokenizeSPDX(expression string) []spdxToken {
+	tokens := []spdxToken{}
+	start := -1
+	for position, char := range expression + " " {
+		isDelimiter := char == '(' || char == ')' || char == ' ' || char == '\t' || char == '\n' || char == '\r'
+		if isDelimiter && start >= 0 {
+			tokens = append(tokens, spdxToken{Value: expression[start:position], Position: start + 1})
+			start = -1
+		}
+		if char == '(' || char == ')' {
+			tokens = append(tokens, spdxToken{Value: string(char), Position: position + 1})
+		}
+		if !isDelimiter && start < 0 {
+			start = position
+		}
+	}
+	return tokens
+}
+
+func (p *spdxParser) peek() (spdxToken, bool) {
+	if p.index >= len(p.tokens) {
+		return spdxToken{Value: "", Position: p.length + 1}, false
+	}
+	return p.tokens[p.index], true
+}
+
+// Reports whether the next token is the operator, case-insensitively, and consumes it if so.
+func (p *spdxParser) acceptOperator(operator string) bool {
+	token, ok := p.peek()
+	if ok && strings.EqualFold(token.Value, operator) {
+		p.index++
+		return true
+	}
+	return false
+}
+
+func (p *spdxParser) parseOr() (spdxExpression, error) {
+	return p.parseOperator(SPDXOperatorOr, p.parseAnd)
+}
+
+func (p *spdxParser) parseAnd() (spdxExpression, error) {
+	return p.parseOperator(SPDXOperatorAnd, p.parseWith)
+}
+
+// Parses operands joined by operator, flattening operands which are themselves joined by the same operator.
+func (p *spdxParser) parseOperator(operator string, parseOperand func() (spdxExpression, error)) (spdxExpression, error) {
+	first, err := parseOperand()
+	if err != nil {
+		return first, err
+	}
+	operands := []spdxExpression{first}
+	for p.acceptOperator(operator) {
+		operand, err := parseOperand()
+		if err != nil {
+			return operand, err
+		}
+		operands = append(operands, operand)
+	}
+	if len(operands) == 1 {
+		return first, nil
+	}
+	flattened := []spdxExpression{}
+	for _, operand := range operands {
+		if operand.Operator == operator {
+			flattened = append(flattened, operand.Operands...)
+		} else {
+			flattened = append(flattened, operand)
+		}
+	}
+	return spdxExpression{Operator: operator, License: "", Operands: flattened}, nil
+}
+
+func (p *spdxParser) parseWith() (spdxExpression, error) {
+	operand, err := p.parsePrimary()
+	if err != nil || !p.acceptOperator(SPDXOperatorWith) {
+		return operand, err
+	}
+	if operand.Operator != "" {
+		return operand, errors.New("'WITH' must follow a license, rather than a compound expression")
+	}
+	token, ok := p.peek()
+	if !ok || !spdxExceptionPattern.MatchString(token.Value) || isSPDXOperator(token.Value) {
+		return operand, fmt.Errorf("expected license exception after 'WITH' at position %d", token.Position)
+	}
+	p.index++
+	exception := spdxExpression{Operator: "", License: token.Value, Operands: nil}
+	return spdxExpression{Operator: SPDXOperatorWith, License: "", Operands: []spdxExpression{operand, exception}}, nil
+}
+
+func (p *spdxParser) parsePrimary() (spdxExpression, error) {
+	token, ok := p.peek()
+	if !ok {
+		return spdxExpression{}, fmt.Errorf("expected license at end of expression, position %d", token.Position)
+	}
+	p.index++
+	if token.Value == "(" {
+		inner, err := p.parseOr()
+		if err != nil {
+			return inner, err
+		}
+		closing, ok := p.peek()
+		if !ok || closing.Value != ")" {
+			return inner, fmt.Errorf("expected ')' at position %d, to close '(' at position %d", closing.Position, token.Position)
+		}
+		p.index++
+		return inner, nil
+	}
+	if token.Value == ")" || isSPDXOperator(token.Value) || !spdxLicensePattern.MatchString(token.Value) {
+		return spdxExpression{}, fmt.Errorf("expected license at position %d, received '%s'", token.Position, token.Value)
+	}
+	return spdxExpression{Operator: "", License: token.Value, Operands: nil}, nil
+}
+
+func isSPDXOperator(value string) bool {
+	return strings.EqualFold(value, SPDXOperatorAnd) || strings.EqualFold(value, SPDXOperatorOr) || strings.EqualFold(value, SPDXOperatorWith)
+}
+
+// String formats the expression, with parentheses only where required by precedence, of 'WITH', then 'AND', then 'OR'.
+func (e spdxExpression) String() string {
+	if e.Operator == "" {
+		return e.License
+	}
+	operands := Map(e.Operands, func(operand spdxExpression) string {
+		if e.Operator == SPDXOperatorAnd && operand.Operator == SPDXOperatorOr {
+			return "(" + operand.String() + ")"
+		}
+		return operand.String()
+	})
+	return strings.Join(operands, " "+e.Operator+" ")
+}
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestNormalizeSPDXExpression(t *testing.T) {
+	for input, expected := range map[string]string{
+		"MIT":                                   "MIT",
+		"  mit  ":                               "mit",
+		"MIT or Apache-2.0":                     "MIT OR Apache-2.0",
+		"((MIT))":                               "MIT",
+		"(MIT OR Apache-2.0) OR BSD-3-Clause":   "MIT OR Apache-2.0 OR BSD-3-Clause",
+		"MIT AND (Apache-2.0 AND BSD-3-Clause)": "MIT AND Apache-2.0 AND BSD-3-Clause",
+		"(MIT AND Apache-2.0) OR BSD-3-Clause":  "MIT AND Apache-2.0 OR BSD-3-Clause",
+		"MIT AND (Apache-2.0 OR BSD-3-Clause)":  "MIT AND (Apache-2.0 OR BSD-3-Clause)",
+		"GPL-2.0-only with Classpath-exception-2.0 and(MIT)":           "GPL-2.0-only WITH Classpath-exception-2.0 AND MIT",
+		"GPL-2.0+ OR DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2": "GPL-2.0+ OR DocumentRef-spdx-tool-1.2:LicenseRef-MIT-Style-2",
+	} {
+		normalized, err := normalizeSPDXExpression(input)
+		requireNoError(t, err)
+		requireEqual(t, normalized, expected)
+	}
+	for input, expected := range map[string]string{
+		"":                      "^expression is empty$",
+		"MIT OR":                "^expected license at end of expression, position 7$",
+		"MIT Apache-2.0":        "^unexpected 'Apache-2.0' at position 5$",
+		"(MIT OR Apache-2.0":    "^expected '\\)' at position 19, to close '\\(' at position 1$",
+		"MIT OR Apache-2.0)":    "^unexpected '\\)' at position 18$",
+		"MIT OR Apache@2.0":     "^expected license at position 8, received 'Apache@2.0'$",
+		"(MIT OR GPL) WITH foo": "^'WITH' must follow a license, rather than a compound expression$",
+		"GPL-2.0 WITH AND":      "^expected license exception after 'WITH' at position 14$",
+	} {
+		_, err := normalizeSPDXExpression(input)
+		requireError(t, err, expected)
+	}
+}
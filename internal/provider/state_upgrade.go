@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Upgrades state from schema version 0, in which attributes now of set type were lists.
+// Lists and sets share a JSON representation, so prior state is decoded with the current schema,
+// then duplicate elements, which a list permits, are removed from each set.
+func upgradeListsToSets(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	if req.RawState == nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade state",
+			"Within UpgradeState, prior state is missing.",
+		)
+		return
+	}
+	raw, err := req.RawState.Unmarshal(resp.State.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade state",
+			"Within UpgradeState, unable to decode prior state. Error from: "+err.Error(),
+		)
+		return
+	}
+	raw, err = tftypes.Transform(raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.Type().Is(tftypes.Set{}) || !value.IsFullyKnown() || value.IsNull() {
+			return value, nil
+		}
+		var elements []tftypes.Value
+		if asErr := value.As(&elements); asErr != nil {
+			return value, asErr
+		}
+		unique := make([]tftypes.Value, 0, len(elements))
+		for _, element := range elements {
+			if !slices.ContainsFunc(unique, element.Equal) {
+				unique = append(unique, element)
+			}
+		}
+		return tftypes.NewValue(value.Type(), unique), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to upgrade state",
+			"Within UpgradeState, unable to convert lists into sets. Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.State.Raw = raw
+}
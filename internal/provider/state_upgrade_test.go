@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUpgradeListsToSets(t *testing.T) {
+	ctx := t.Context()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	requireNoError(t, err)
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	requireNoError(t, err)
+	stateType := schemas.ResourceSchemas["dependencytrack_project"].ValueType()
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: "dependencytrack_project",
+		Version:  0,
+		RawState: &tfprotov6.RawState{JSON: []byte(`{
+			"id": "c82d6f01-a7a4-41d6-9b03-4f06497f575b",
+			"name": "Project",
+			"tags": ["b", "a", "b"],
+			"active": true
+		}`)},
+	})
+	requireNoError(t, err)
+	if len(resp.Diagnostics) > 0 {
+		t.Fatalf("Expected no diagnostics, received %v", resp.Diagnostics[0])
+	}
+	upgraded, err := resp.UpgradedState.Unmarshal(stateType)
+	requireNoError(t, err)
+
+	var attributes map[string]tftypes.Value
+	requireNoError(t, upgraded.As(&attributes))
+	var tags []tftypes.Value
+	requireNoError(t, attributes["tags"].As(&tags))
+	requireEqual(t, len(tags), 2)
+	requireEqual(t, attributes["tags"].Type().Is(tftypes.Set{}), true)
+	requireEqual(t, attributes["tags_all"].IsNull(), true)
+	var name string
+	requireNoError(t, attributes["name"].As(&name))
+	requireEqual(t, name, "Project")
+}
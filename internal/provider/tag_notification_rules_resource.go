@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
@@ -17,9 +16,11 @@ import (
 )
 
 var (
-	_ resource.Resource                = &tagNotificationRulesResource{}
-	_ resource.ResourceWithConfigure   = &tagNotificationRulesResource{}
-	_ resource.ResourceWithImportState = &tagNotificationRulesResource{}
+	_ resource.Resource                 = &tagNotificationRulesResource{}
+	_ resource.ResourceWithConfigure    = &tagNotificationRulesResource{}
+	_ resource.ResourceWithImportState  = &tagNotificationRulesResource{}
+	_ resource.ResourceWithIdentity     = &tagNotificationRulesResource{}
+	_ resource.ResourceWithUpgradeState = &tagNotificationRulesResource{}
 )
 
 type (
@@ -45,6 +46,7 @@ func (*tagNotificationRulesResource) Metadata(_ context.Context, req resource.Me
 
 func (*tagNotificationRulesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     1,
 		Description: "Applies an existing tag to multiple notification rules. Requires API version >= 4.12.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -61,7 +63,7 @@ func (*tagNotificationRulesResource) Schema(_ context.Context, _ resource.Schema
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"notification_rules": schema.ListAttribute{
+			"notification_rules": schema.SetAttribute{
 				Description: "Notification Rule UUIDs to which to apply tag.",
 				Required:    true,
 				ElementType: types.StringType,
@@ -70,6 +72,21 @@ func (*tagNotificationRulesResource) Schema(_ context.Context, _ resource.Schema
 	}
 }
 
+var tagNotificationRulesIdentity = resourceIdentity{
+	{Name: "tag", State: path.Root("id"), Description: "Name of the Tag."},
+}
+
+func (*tagNotificationRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tagNotificationRulesIdentity.Schema()
+}
+
+func (*tagNotificationRulesResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held `notification_rules` as a list.
+		0: {StateUpgrader: upgradeListsToSets},
+	}
+}
+
 func (r *tagNotificationRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan tagNotificationRulesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -143,6 +160,7 @@ func (r *tagNotificationRulesResource) Create(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagNotificationRulesIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Tag NotificationRules Mapping", map[string]any{
 		"id":                 plan.ID.ValueString(),
 		"tag":                plan.Tag.ValueString(),
@@ -151,6 +169,7 @@ func (r *tagNotificationRulesResource) Create(ctx context.Context, req resource.
 }
 
 func (r *tagNotificationRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(tagNotificationRulesIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state tagNotificationRulesResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -168,6 +187,9 @@ func (r *tagNotificationRulesResource) Read(ctx context.Context, req resource.Re
 	taggedNotificationRulesInfo, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
 		return r.client.Tag.GetNotificationRules(ctx, tagName, po, dtrack.SortOptions{})
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": tagName}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to get current list of notification rules for tag: "+tagName,
@@ -180,12 +202,6 @@ func (r *tagNotificationRulesResource) Read(ctx context.Context, req resource.Re
 		return types.StringValue(info.UUID.String())
 	})
 
-	if SliceUnorderedEqual(stateNotificationRules, state.NotificationRules, func(a, b types.String) int {
-		return strings.Compare(a.ValueString(), b.ValueString())
-	}) {
-		stateNotificationRules = state.NotificationRules
-	}
-
 	newState := tagNotificationRulesResourceModel{
 		ID:                types.StringValue(tagName),
 		Tag:               types.StringValue(tagName),
@@ -285,6 +301,7 @@ func (r *tagNotificationRulesResource) Update(ctx context.Context, req resource.
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagNotificationRulesIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Tag NotificationRules Mapping", map[string]any{
 		"id":                 newState.ID.ValueString(),
 		"tag":                newState.Tag.ValueString(),
@@ -340,7 +357,7 @@ func (*tagNotificationRulesResource) ImportState(ctx context.Context, req resour
 	tflog.Debug(ctx, "Importing Tag NotificationRules Mapping", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tagNotificationRulesIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
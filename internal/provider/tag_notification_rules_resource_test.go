@@ -45,12 +45,12 @@ resource "dependencytrack_tag_notification_rules" "test" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "id", "tag_notification_rules"),
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "notification_rules.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_a", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_z", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "tag", "tag_notification_rules"),
@@ -102,16 +102,16 @@ resource "dependencytrack_tag_notification_rules" "test" {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "id", "tag_notification_rules"),
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "notification_rules.#", "3"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_a", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_b", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.2",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_z", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "tag", "tag_notification_rules"),
@@ -159,12 +159,12 @@ resource "dependencytrack_tag_notification_rules" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "notification_rules.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_z", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_a", "id",
 					),
 				),
@@ -208,16 +208,16 @@ resource "dependencytrack_tag_notification_rules" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_notification_rules.test", "notification_rules.#", "3"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_z", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_a", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_notification_rules.test", "notification_rules.2",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_notification_rules.test", "notification_rules.*",
 						"dependencytrack_notification_rule.test_b", "id",
 					),
 				),
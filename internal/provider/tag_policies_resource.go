@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
@@ -17,9 +16,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &tagPoliciesResource{}
-	_ resource.ResourceWithConfigure   = &tagPoliciesResource{}
-	_ resource.ResourceWithImportState = &tagPoliciesResource{}
+	_ resource.Resource                 = &tagPoliciesResource{}
+	_ resource.ResourceWithConfigure    = &tagPoliciesResource{}
+	_ resource.ResourceWithImportState  = &tagPoliciesResource{}
+	_ resource.ResourceWithIdentity     = &tagPoliciesResource{}
+	_ resource.ResourceWithUpgradeState = &tagPoliciesResource{}
+	_ resource.ResourceWithMoveState    = &tagPoliciesResource{}
 )
 
 type (
@@ -45,7 +47,9 @@ func (*tagPoliciesResource) Metadata(_ context.Context, req resource.MetadataReq
 
 func (*tagPoliciesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Applies an existing tag to multiple policies. Requires API version >= 4.12.",
+		Version: 1,
+		Description: "Applies an existing tag to multiple policies. Requires API version >= 4.12. " +
+			"Supports `moved` from a `dependencytrack_policy_tag`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Name of the Tag.",
@@ -61,7 +65,7 @@ func (*tagPoliciesResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"policies": schema.ListAttribute{
+			"policies": schema.SetAttribute{
 				Description: "Policy UUIDs to which to apply tag.",
 				Required:    true,
 				ElementType: types.StringType,
@@ -70,6 +74,54 @@ func (*tagPoliciesResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+var tagPoliciesIdentity = resourceIdentity{
+	{Name: "tag", State: path.Root("id"), Description: "Name of the Tag."},
+}
+
+func (*tagPoliciesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tagPoliciesIdentity.Schema()
+}
+
+func (*tagPoliciesResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held `policies` as a list.
+		0: {StateUpgrader: upgradeListsToSets},
+	}
+}
+
+func (*tagPoliciesResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// From a single `dependencytrack_policy_tag`. Other policies for the tag are added on the next apply.
+			SourceSchema: sourceSchema(ctx, NewPolicyTagResource()),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, resp, "dependencytrack_policy_tag") {
+					return
+				}
+				var source policyTagResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := tagPoliciesResourceModel{
+					ID:       source.Tag,
+					Tag:      source.Tag,
+					Policies: []types.String{source.PolicyID},
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(tagPoliciesIdentity.Set(ctx, resp.TargetState, resp.TargetIdentity)...)
+				tflog.Debug(ctx, "Moved Policy Tag into Tag Policies", map[string]any{
+					"tag":      target.Tag.ValueString(),
+					"policies": Map(target.Policies, types.String.ValueString),
+				})
+			},
+		},
+	}
+}
+
 func (r *tagPoliciesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan tagPoliciesResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -143,6 +195,7 @@ func (r *tagPoliciesResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagPoliciesIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Tag Policies", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"tag":      plan.Tag.ValueString(),
@@ -151,6 +204,7 @@ func (r *tagPoliciesResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *tagPoliciesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(tagPoliciesIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state tagPoliciesResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -168,6 +222,9 @@ func (r *tagPoliciesResource) Read(ctx context.Context, req resource.ReadRequest
 	taggedPoliciesInfo, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedPolicyListResponseItem], error) {
 		return r.client.Tag.GetPolicies(ctx, tagName, po, dtrack.SortOptions{})
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": tagName}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to get current list of policies for tag: "+tagName,
@@ -180,12 +237,6 @@ func (r *tagPoliciesResource) Read(ctx context.Context, req resource.ReadRequest
 		return types.StringValue(info.UUID.String())
 	})
 
-	if SliceUnorderedEqual(statePolicies, state.Policies, func(a, b types.String) int {
-		return strings.Compare(a.ValueString(), b.ValueString())
-	}) {
-		statePolicies = state.Policies
-	}
-
 	state = tagPoliciesResourceModel{
 		ID:       types.StringValue(tagName),
 		Tag:      types.StringValue(tagName),
@@ -285,6 +336,7 @@ func (r *tagPoliciesResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagPoliciesIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Tag Policies", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"tag":      plan.Tag.ValueString(),
@@ -340,7 +392,7 @@ func (*tagPoliciesResource) ImportState(ctx context.Context, req resource.Import
 	tflog.Debug(ctx, "Importing Tag Policies", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tagPoliciesIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -39,12 +39,12 @@ resource "dependencytrack_tag_policies" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_policies.test", "policies.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_policies.test", "policies.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_policies.test", "policies.*",
 						"dependencytrack_policy.test", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_policies.test", "policies.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_policies.test", "policies.*",
 						"dependencytrack_policy.test2", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_tag_policies.test", "tag", "test_tag_policies_tag"),
@@ -84,12 +84,12 @@ resource "dependencytrack_tag_policies" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_policies.test", "policies.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_policies.test", "policies.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_policies.test", "policies.*",
 						"dependencytrack_policy.test", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_policies.test", "policies.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_policies.test", "policies.*",
 						"dependencytrack_policy.test2", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_tag_policies.test", "tag", "test_tag_policies_tag"),
@@ -132,8 +132,8 @@ resource "dependencytrack_tag_policies" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_policies.test", "policies.#", "2"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_policies.test", "policies.0", "dependencytrack_policy.z", "id"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_policies.test", "policies.1", "dependencytrack_policy.a", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_policies.test", "policies.*", "dependencytrack_policy.z", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_policies.test", "policies.*", "dependencytrack_policy.a", "id"),
 				),
 			},
 			// Update and Read testing.
@@ -170,9 +170,9 @@ resource "dependencytrack_tag_policies" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_policies.test", "policies.#", "3"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_policies.test", "policies.0", "dependencytrack_policy.z", "id"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_policies.test", "policies.1", "dependencytrack_policy.a", "id"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_policies.test", "policies.2", "dependencytrack_policy.b", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_policies.test", "policies.*", "dependencytrack_policy.z", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_policies.test", "policies.*", "dependencytrack_policy.a", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_policies.test", "policies.*", "dependencytrack_policy.b", "id"),
 				),
 			},
 		},
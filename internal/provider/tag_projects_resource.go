@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
@@ -17,9 +16,12 @@ import (
 )
 
 var (
-	_ resource.Resource                = &tagProjectsResource{}
-	_ resource.ResourceWithConfigure   = &tagProjectsResource{}
-	_ resource.ResourceWithImportState = &tagProjectsResource{}
+	_ resource.Resource                 = &tagProjectsResource{}
+	_ resource.ResourceWithConfigure    = &tagProjectsResource{}
+	_ resource.ResourceWithImportState  = &tagProjectsResource{}
+	_ resource.ResourceWithIdentity     = &tagProjectsResource{}
+	_ resource.ResourceWithUpgradeState = &tagProjectsResource{}
+	_ resource.ResourceWithMoveState    = &tagProjectsResource{}
 )
 
 type (
@@ -45,7 +47,9 @@ func (*tagProjectsResource) Metadata(_ context.Context, req resource.MetadataReq
 
 func (*tagProjectsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Applies an existing tag to multiple projects. Requires API version >= 4.12.",
+		Version: 1,
+		Description: "Applies an existing tag to multiple projects. Requires API version >= 4.12. " +
+			"Supports `moved` from a `dependencytrack_project` with a single tag.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "Name of the Tag.",
@@ -61,7 +65,7 @@ func (*tagProjectsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"projects": schema.ListAttribute{
+			"projects": schema.SetAttribute{
 				Description: "Project UUIDs to which to apply tag.",
 				Required:    true,
 				ElementType: types.StringType,
@@ -70,6 +74,71 @@ func (*tagProjectsResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+var tagProjectsIdentity = resourceIdentity{
+	{Name: "tag", State: path.Root("id"), Description: "Name of the Tag."},
+}
+
+func (*tagProjectsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tagProjectsIdentity.Schema()
+}
+
+func (*tagProjectsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held `projects` as a list.
+		0: {StateUpgrader: upgradeListsToSets},
+	}
+}
+
+func (*tagProjectsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// From `dependencytrack_project`, with a single tag. Other projects with the tag are added on the next apply.
+			SourceSchema: sourceSchema(ctx, NewProjectResource()),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, resp, "dependencytrack_project") {
+					return
+				}
+				var source projectResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				tags, err := GetStringList(ctx, &resp.Diagnostics, source.Tags)
+				if err != nil {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("tags"),
+						"Within MoveState, unable to convert `tags` set into slice of string, in project: "+source.ID.ValueString(),
+						"Error from: "+err.Error(),
+					)
+					return
+				}
+				if len(tags) != 1 {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("tags"),
+						"Unable to Move Resource State",
+						fmt.Sprintf("Within MoveState, expected a single tag on project '%s', received %d.", source.ID.ValueString(), len(tags)),
+					)
+					return
+				}
+				target := tagProjectsResourceModel{
+					ID:       types.StringValue(tags[0]),
+					Tag:      types.StringValue(tags[0]),
+					Projects: []types.String{source.ID},
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(tagProjectsIdentity.Set(ctx, resp.TargetState, resp.TargetIdentity)...)
+				tflog.Debug(ctx, "Moved Project into Tag Projects", map[string]any{
+					"tag":      target.Tag.ValueString(),
+					"projects": Map(target.Projects, types.String.ValueString),
+				})
+			},
+		},
+	}
+}
+
 func (r *tagProjectsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan tagProjectsResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -143,6 +212,7 @@ func (r *tagProjectsResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagProjectsIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Tag Projects", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"tag":      plan.Tag.ValueString(),
@@ -151,6 +221,7 @@ func (r *tagProjectsResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (r *tagProjectsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(tagProjectsIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state tagProjectsResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -169,6 +240,9 @@ func (r *tagProjectsResource) Read(ctx context.Context, req resource.ReadRequest
 	taggedProjectsInfo, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.TaggedProjectListResponseItem], error) {
 		return r.client.Tag.GetProjects(ctx, tagName, po, dtrack.SortOptions{})
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": tagName}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to get current list of projects for tag: "+tagName,
@@ -181,12 +255,6 @@ func (r *tagProjectsResource) Read(ctx context.Context, req resource.ReadRequest
 		return types.StringValue(info.UUID.String())
 	})
 
-	if SliceUnorderedEqual(stateProjects, state.Projects, func(a, b types.String) int {
-		return strings.Compare(a.ValueString(), b.ValueString())
-	}) {
-		stateProjects = state.Projects
-	}
-
 	state = tagProjectsResourceModel{
 		ID:       types.StringValue(tagName),
 		Tag:      types.StringValue(tagName),
@@ -286,6 +354,7 @@ func (r *tagProjectsResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagProjectsIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Tag Projects", map[string]any{
 		"id":       plan.ID.ValueString(),
 		"tag":      plan.Tag.ValueString(),
@@ -341,7 +410,7 @@ func (*tagProjectsResource) ImportState(ctx context.Context, req resource.Import
 	tflog.Debug(ctx, "Importing Tag Projects", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tagProjectsIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
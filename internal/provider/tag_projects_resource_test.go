@@ -36,12 +36,12 @@ data "dependencytrack_project" "test2" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.test", "projects.#", "2"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_projects.test", "projects.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_projects.test", "projects.*",
 						"dependencytrack_project.test", "id",
 					),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_projects.test", "projects.1",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_projects.test", "projects.*",
 						"dependencytrack_project.test2", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.test", "tag", "test_projects_tag"),
@@ -79,8 +79,8 @@ data "dependencytrack_project" "test2" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.test", "projects.#", "1"),
-					resource.TestCheckResourceAttrPair(
-						"dependencytrack_tag_projects.test", "projects.0",
+					resource.TestCheckTypeSetElemAttrPair(
+						"dependencytrack_tag_projects.test", "projects.*",
 						"dependencytrack_project.test", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.test", "tag", "test_projects_tag"),
@@ -114,8 +114,8 @@ resource "dependencytrack_tag_projects" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.test", "projects.#", "2"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_projects.test", "projects.0", "dependencytrack_project.z", "id"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_projects.test", "projects.1", "dependencytrack_project.a", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_projects.test", "projects.*", "dependencytrack_project.z", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_projects.test", "projects.*", "dependencytrack_project.a", "id"),
 				),
 			},
 			// Update and Read testing.
@@ -141,9 +141,9 @@ resource "dependencytrack_tag_projects" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_tag_projects.test", "projects.#", "3"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_projects.test", "projects.0", "dependencytrack_project.z", "id"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_projects.test", "projects.1", "dependencytrack_project.a", "id"),
-					resource.TestCheckResourceAttrPair("dependencytrack_tag_projects.test", "projects.2", "dependencytrack_project.b", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_projects.test", "projects.*", "dependencytrack_project.z", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_projects.test", "projects.*", "dependencytrack_project.a", "id"),
+					resource.TestCheckTypeSetElemAttrPair("dependencytrack_tag_projects.test", "projects.*", "dependencytrack_project.b", "id"),
 				),
 			},
 		},
@@ -18,6 +18,7 @@ var (
 	_ resource.Resource                = &tagResource{}
 	_ resource.ResourceWithConfigure   = &tagResource{}
 	_ resource.ResourceWithImportState = &tagResource{}
+	_ resource.ResourceWithIdentity    = &tagResource{}
 )
 
 type (
@@ -62,6 +63,14 @@ func (*tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *re
 	}
 }
 
+var tagIdentity = resourceIdentity{
+	{Name: "name", State: path.Root("id"), Description: "Name of the Tag."},
+}
+
+func (*tagResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = tagIdentity.Schema()
+}
+
 func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan tagResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -92,6 +101,7 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Tag", map[string]any{
 		"id":   plan.ID.ValueString(),
 		"name": plan.Name.ValueString(),
@@ -99,6 +109,7 @@ func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(tagIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state tagResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -119,6 +130,9 @@ func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}, func(tag dtrack.TagListResponseItem) bool {
 		return tag.Name == tagID
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": tagID}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to get updated tag",
@@ -172,6 +186,7 @@ func (*tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(tagIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Tag", map[string]any{
 		"id":   plan.ID.ValueString(),
 		"name": plan.Name.ValueString(),
@@ -213,7 +228,7 @@ func (*tagResource) ImportState(ctx context.Context, req resource.ImportStateReq
 	tflog.Debug(ctx, "Importing Tag", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	tagIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
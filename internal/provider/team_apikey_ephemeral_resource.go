@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &teamAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &teamAPIKeyEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &teamAPIKeyEphemeralResource{}
+)
+
+// Private data key, holding the public id or key of a generated API Key, to delete within Close when `revoke_on_close`.
+const teamAPIKeyPrivateGenerated = "generated"
+
+type (
+	teamAPIKeyEphemeralResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	teamAPIKeyEphemeralResourceModel struct {
+		TeamID        types.String `tfsdk:"team"`
+		Key           types.String `tfsdk:"key"`
+		Comment       types.String `tfsdk:"comment"`
+		Masked        types.String `tfsdk:"masked"`
+		PublicID      types.String `tfsdk:"public_id"`
+		Legacy        types.Bool   `tfsdk:"legacy"`
+		RevokeOnClose types.Bool   `tfsdk:"revoke_on_close"`
+	}
+)
+
+func NewTeamAPIKeyEphemeralResource() ephemeral.EphemeralResource {
+	return &teamAPIKeyEphemeralResource{}
+}
+
+func (*teamAPIKeyEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team_apikey"
+}
+
+func (*teamAPIKeyEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Generates an API Key for a Team, or looks up an existing API Key by `public_id` or `masked`. " +
+			"As ephemeral resources are opened on every plan and apply, a new API Key is generated for each run, and remains valid afterwards. " +
+			"With `revoke_on_close`, the API Key only lasts for the run, so must not be passed to write-only arguments, or otherwise stored. " +
+			"The key is never persisted to state. Requires Terraform 1.10+.",
+		Attributes: map[string]schema.Attribute{
+			"team": schema.StringAttribute{
+				Description: "UUID for the Team of the API Key.",
+				Required:    true,
+			},
+			"key": schema.StringAttribute{
+				Description: "The API Key.",
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"comment": schema.StringAttribute{
+				Description: "The comment to assign to a generated API Key.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"masked": schema.StringAttribute{
+				Description: "The masked API Key. If set, looks up the existing API Key, rather than generating one.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"public_id": schema.StringAttribute{
+				Description: "The public identifier for API Keys in DependencyTrack 4.13+. " +
+					"If set, looks up the existing API Key, rather than generating one. " +
+					"Only legacy API Keys, generated before DependencyTrack 4.13, can be looked up, as others are not returned by the API.",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{stringvalidator.ConflictsWith(path.MatchRoot("masked"))},
+			},
+			"legacy": schema.BoolAttribute{
+				Description: "Whether the API Key is generated by DependencyTrack pre-4.13.",
+				Computed:    true,
+			},
+			"revoke_on_close": schema.BoolAttribute{
+				Description: "Whether to delete a generated API Key once Terraform no longer needs it, at the end of the run. " +
+					"Looked up API Keys are never deleted. Defaults to false.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *teamAPIKeyEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config teamAPIKeyEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	teamID, idDiag := TryParseUUID(config.TeamID, LifecycleOpen, path.Root("team"))
+	if idDiag != nil {
+		resp.Diagnostics.Append(idDiag)
+		return
+	}
+
+	var key dtrack.APIKey
+	if config.PublicID.ValueString() != "" || config.Masked.ValueString() != "" {
+		key = r.lookup(ctx, &resp.Diagnostics, teamID, config)
+	} else {
+		key = r.generate(ctx, &resp.Diagnostics, teamID, config)
+		if !resp.Diagnostics.HasError() && config.RevokeOnClose.ValueBool() {
+			generated, err := json.Marshal(r.publicIDOrKey(key))
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Within Open, unable to record generated API Key",
+					"Error from: "+err.Error(),
+				)
+			} else {
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, teamAPIKeyPrivateGenerated, generated)...)
+			}
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result := teamAPIKeyEphemeralResourceModel{
+		TeamID:        types.StringValue(teamID.String()),
+		Key:           types.StringValue(key.Key),
+		Comment:       types.StringValue(key.Comment),
+		Masked:        types.StringValue(key.MaskedKey),
+		PublicID:      types.StringValue(key.PublicId),
+		Legacy:        types.BoolValue(key.Legacy),
+		RevokeOnClose: config.RevokeOnClose,
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Opened API Key", map[string]any{
+		"team":    result.TeamID.ValueString(),
+		"masked":  result.Masked.ValueString(),
+		"comment": result.Comment.ValueString(),
+	})
+}
+
+func (r *teamAPIKeyEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	generated, diags := req.Private.GetKey(ctx, teamAPIKeyPrivateGenerated)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || generated == nil {
+		return
+	}
+	var publicIDOrKey string
+	if err := json.Unmarshal(generated, &publicIDOrKey); err != nil {
+		resp.Diagnostics.AddError(
+			"Within Close, unable to load generated API Key",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting generated API Key")
+	err := r.client.Team.DeleteAPIKey(ctx, publicIDOrKey)
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Within Close, unable to delete generated API Key",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted generated API Key")
+}
+
+func (r *teamAPIKeyEphemeralResource) generate(
+	ctx context.Context, diags *diag.Diagnostics, teamID uuid.UUID, config teamAPIKeyEphemeralResourceModel,
+) dtrack.APIKey {
+	tflog.Debug(ctx, "Generating API Key", map[string]any{
+		"team": teamID.String(),
+	})
+	key, err := r.client.Team.GenerateAPIKey(ctx, teamID)
+	if err != nil {
+		diags.AddError(
+			"Within Open, unable to generate API Key for team: "+teamID.String(),
+			"Error from: "+err.Error(),
+		)
+		return key
+	}
+	if comment := config.Comment.ValueString(); comment != "" {
+		key.Comment, err = r.client.Team.UpdateAPIKeyComment(ctx, key.Key, comment)
+		if err != nil {
+			diags.AddError(
+				"Within Open, unable to set comment of generated API Key",
+				"Error from: "+err.Error(),
+			)
+			// Close is not invoked when Open fails, so the key would otherwise remain valid, without being returned.
+			if err = r.client.Team.DeleteAPIKey(ctx, r.publicIDOrKey(key)); err != nil {
+				diags.AddError(
+					"Within Open, unable to delete generated API Key: "+key.MaskedKey,
+					"Error from: "+err.Error(),
+				)
+			}
+		}
+	}
+	return key
+}
+
+// Identifier of an API Key for DeleteAPIKey, being the key itself for legacy keys.
+func (r *teamAPIKeyEphemeralResource) publicIDOrKey(key dtrack.APIKey) string {
+	if isLegacyAPIKey(r.semver, key) {
+		return key.Key
+	}
+	return key.PublicId
+}
+
+func (r *teamAPIKeyEphemeralResource) lookup(
+	ctx context.Context, diags *diag.Diagnostics, teamID uuid.UUID, config teamAPIKeyEphemeralResourceModel,
+) dtrack.APIKey {
+	publicID := config.PublicID.ValueString()
+	masked := config.Masked.ValueString()
+	tflog.Debug(ctx, "Looking up API Key", map[string]any{
+		"team":      teamID.String(),
+		"public_id": publicID,
+		"masked":    masked,
+	})
+	keys, err := r.client.Team.GetAPIKeys(ctx, teamID)
+	if err != nil {
+		diags.AddError(
+			"Within Open, unable to read API Keys for team: "+teamID.String(),
+			"Error from: "+err.Error(),
+		)
+		return dtrack.APIKey{}
+	}
+	found, err := Find(keys, func(apiKey dtrack.APIKey) bool {
+		if publicID != "" {
+			return apiKey.PublicId == publicID
+		}
+		return apiKey.MaskedKey == masked
+	})
+	if err != nil {
+		diags.AddError(
+			"Within Open, unable to find API Key for team: "+teamID.String(),
+			"Error from: "+err.Error(),
+		)
+		return dtrack.APIKey{}
+	}
+	key := *found
+	if key.Key == "" || key.Key == key.MaskedKey {
+		diags.AddError(
+			"Within Open, unable to look up API Key for team: "+teamID.String(),
+			"DependencyTrack does not return the key of API Keys generated in 4.13+. Omit `public_id` and `masked` to generate an API Key instead.",
+		)
+	}
+	return key
+}
+
+func (r *teamAPIKeyEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccTeamAPIKeyEphemeralResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_team" "test" {
+	name = "Test_Team_Ephemeral_APIKey"
+}
+ephemeral "dependencytrack_team_apikey" "test" {
+	team = dependencytrack_team.test.id
+	comment = "Ephemeral"
+}
+provider "echo" {
+	data = ephemeral.dependencytrack_team_apikey.test
+}
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.test", "data.team", "dependencytrack_team.test", "id"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.key"),
+					resource.TestCheckResourceAttrSet("echo.test", "data.masked"),
+					resource.TestCheckResourceAttr("echo.test", "data.comment", "Ephemeral"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_team" "test" {
+	name = "Test_Team_Ephemeral_APIKey"
+}
+ephemeral "dependencytrack_team_apikey" "test" {
+	team = dependencytrack_team.test.id
+	comment = "Ephemeral"
+	revoke_on_close = true
+}
+provider "echo" {
+	data = ephemeral.dependencytrack_team_apikey.test
+}
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.key"),
+					resource.TestCheckResourceAttr("echo.test", "data.revoke_on_close", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestTeamAPIKeyEphemeralResourceGenerateDeletesOnCommentError(t *testing.T) {
+	teamID := uuid.MustParse("c82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	var deleted string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/version":
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"version":"4.13.0"}`))
+		case "PUT /api/v1/team/" + teamID.String() + "/key":
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key":"odt_abc_secret","publicId":"abc","maskedKey":"odt_abc****","legacy":false}`))
+		case "POST /api/v1/team/key/odt_abc_secret/comment":
+			w.WriteHeader(http.StatusInternalServerError)
+		case "DELETE /api/v1/team/key/abc":
+			deleted = "abc"
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, err := dtrack.NewClient(server.URL)
+	requireNoError(t, err)
+	r := teamAPIKeyEphemeralResource{client: client, semver: &Semver{Major: 4, Minor: 13, Patch: 0}}
+
+	var diags diag.Diagnostics
+	r.generate(t.Context(), &diags, teamID, teamAPIKeyEphemeralResourceModel{
+		TeamID:        types.StringValue(teamID.String()),
+		Key:           types.StringNull(),
+		Comment:       types.StringValue("Comment"),
+		Masked:        types.StringNull(),
+		PublicID:      types.StringNull(),
+		Legacy:        types.BoolNull(),
+		RevokeOnClose: types.BoolNull(),
+	})
+	requireEqual(t, diags.ErrorsCount(), 1)
+	requireEqual(t, diags.Errors()[0].Summary(), "Within Open, unable to set comment of generated API Key")
+	requireEqual(t, deleted, "abc")
+}
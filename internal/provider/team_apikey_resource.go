@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &teamAPIKeyResource{}
 	_ resource.ResourceWithConfigure   = &teamAPIKeyResource{}
 	_ resource.ResourceWithImportState = &teamAPIKeyResource{}
+	_ resource.ResourceWithIdentity    = &teamAPIKeyResource{}
 )
 
 type (
@@ -49,7 +50,8 @@ func (*teamAPIKeyResource) Metadata(_ context.Context, req resource.MetadataRequ
 
 func (*teamAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages an API Key for a Team.",
+		Description: "Manages an API Key for a Team. The key is persisted to state, " +
+			"so prefer the `dependencytrack_team_apikey` ephemeral resource with Terraform 1.10+, where the key is only needed during the run.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Description: "ID used by provider. Has no meaning to DependencyTrack.",
@@ -104,6 +106,15 @@ func (*teamAPIKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+var teamAPIKeyIdentity = resourceIdentity{
+	{Name: "team_id", State: path.Root("team"), Description: "UUID of the Team."},
+	{Name: "public_id", State: path.Root("public_id"), Description: "Public ID of the API Key."},
+}
+
+func (*teamAPIKeyResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamAPIKeyIdentity.Schema()
+}
+
 func (r *teamAPIKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan teamAPIKeyResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -165,6 +176,7 @@ func (r *teamAPIKeyResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamAPIKeyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created API Key", map[string]any{
 		"team":    plan.TeamID.ValueString(),
 		"masked":  plan.Masked.ValueString(),
@@ -173,6 +185,7 @@ func (r *teamAPIKeyResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *teamAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(teamAPIKeyIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state teamAPIKeyResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -195,6 +208,9 @@ func (r *teamAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 	})
 
 	keys, err := r.client.Team.GetAPIKeys(ctx, team)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"team": team.String(), "masked": state.Masked.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read API Keys",
@@ -210,6 +226,9 @@ func (r *teamAPIKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 			return apiKey.PublicId == publicID
 		}
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"team": team.String(), "masked": state.Masked.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to find API Key",
@@ -277,6 +296,7 @@ func (r *teamAPIKeyResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamAPIKeyIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated API Key", map[string]any{
 		"team":    plan.TeamID.ValueString(),
 		"masked":  plan.Masked.ValueString(),
@@ -322,11 +342,16 @@ func (r *teamAPIKeyResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *teamAPIKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	idParts := strings.Split(req.ID, "/")
+	importID, idDiags := teamAPIKeyIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		resp.Diagnostics.AddError(
 			"Unexpected import id",
-			"Expected id in format <UUID>/<PublicIdOrKey>. Received "+req.ID,
+			"Expected id in format <UUID>/<PublicIdOrKey>. Received "+importID,
 		)
 		return
 	}
@@ -402,7 +427,12 @@ func (r *teamAPIKeyResource) Configure(_ context.Context, req resource.Configure
 }
 
 func (r *teamAPIKeyResource) isLegacy(key dtrack.APIKey) bool {
-	if r.semver.Major == 4 && r.semver.Minor < 13 {
+	return isLegacyAPIKey(r.semver, key)
+}
+
+// Legacy API Keys, including all before API 4.13, are identified by the key, rather than public id.
+func isLegacyAPIKey(semver *Semver, key dtrack.APIKey) bool {
+	if !semver.Supports(CapabilityAPIKeyPublicID) {
 		return true
 	}
 	return key.Legacy
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &teamListResource{}
+	_ list.ListResourceWithConfigure = &teamListResource{}
+)
+
+type teamListResource struct {
+	managed *teamResource
+}
+
+func NewTeamListResource() list.ListResource {
+	return &teamListResource{managed: &teamResource{}}
+}
+
+func (*teamListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (*teamListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Teams, for `terraform query`.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+}
+
+func (r *teamListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing Teams")
+	teams, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
+		return r.managed.client.Team.GetAll(ctx, po)
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Within List, unable to list teams.",
+			"Error from: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tflog.Debug(ctx, "Listed Teams", map[string]any{
+		"count": len(teams),
+	})
+	streamListResults(ctx, req, stream, r.managed, teamIdentity, teams, func(team dtrack.Team) (string, string) {
+		return team.UUID.String(), team.Name
+	})
+}
+
+func (r *teamListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.managed.Configure(ctx, req, resp)
+}
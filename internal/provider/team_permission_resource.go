@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -17,6 +19,7 @@ import (
 var (
 	_ resource.Resource              = &teamPermissionResource{}
 	_ resource.ResourceWithConfigure = &teamPermissionResource{}
+	_ resource.ResourceWithIdentity  = &teamPermissionResource{}
 )
 
 type (
@@ -51,16 +54,26 @@ func (*teamPermissionResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"permission": schema.StringAttribute{
-				Description: "Permission name to attach to the Team.",
+				Description: "Permission name to attach to the Team. Supports " + describeValues(permissionValues) + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{stringvalidator.OneOf(permissionValues...)},
 			},
 		},
 	}
 }
 
+var teamPermissionIdentity = resourceIdentity{
+	{Name: "team_id", State: path.Root("team"), Description: "UUID of the Team."},
+	{Name: "permission", State: path.Root("permission"), Description: "Name of the Permission."},
+}
+
+func (*teamPermissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamPermissionIdentity.Schema()
+}
+
 func (r *teamPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan teamPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -98,6 +111,7 @@ func (r *teamPermissionResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamPermissionIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Team Permission", map[string]any{
 		"team":       plan.TeamID.ValueString(),
 		"permission": plan.Permission.ValueString(),
@@ -105,6 +119,7 @@ func (r *teamPermissionResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *teamPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(teamPermissionIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state teamPermissionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -124,6 +139,9 @@ func (r *teamPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		"permission": state.Permission.ValueString(),
 	})
 	team, err := r.client.Team.Get(ctx, teamID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"team": teamID.String(), "permission": state.Permission.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated team",
@@ -134,6 +152,9 @@ func (r *teamPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 	permission, err := Find(team.Permissions, func(permission dtrack.Permission) bool {
 		return permission.Name == state.Permission.ValueString()
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"team": teamID.String(), "permission": state.Permission.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to identify team permission",
@@ -172,6 +193,7 @@ func (*teamPermissionResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamPermissionIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Team Permission", map[string]any{
 		"team":       plan.TeamID.ValueString(),
 		"permission": plan.Permission.ValueString(),
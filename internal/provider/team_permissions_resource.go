@@ -3,23 +3,27 @@ package provider
 import (
 	"context"
 	"fmt"
-	"slices"
-	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var (
-	_ resource.Resource              = &teamPermissionsResource{}
-	_ resource.ResourceWithConfigure = &teamPermissionsResource{}
+	_ resource.Resource                 = &teamPermissionsResource{}
+	_ resource.ResourceWithConfigure    = &teamPermissionsResource{}
+	_ resource.ResourceWithIdentity     = &teamPermissionsResource{}
+	_ resource.ResourceWithUpgradeState = &teamPermissionsResource{}
+	_ resource.ResourceWithMoveState    = &teamPermissionsResource{}
 )
 
 type (
@@ -44,7 +48,10 @@ func (*teamPermissionsResource) Metadata(_ context.Context, req resource.Metadat
 
 func (*teamPermissionsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Manages the attachment of Permissions to a Team. Conflicts with `dependencytrack_team_permission`.",
+		Version: 1,
+		Description: "Manages the attachment of Permissions to a Team. Conflicts with `dependencytrack_team_permission`. " +
+			"Supports `moved` from a `dependencytrack_team_permission`. When migrating several for the same Team, move one, " +
+			"and use `removed` with `destroy = false` for the others, so their permissions are not revoked.",
 		Attributes: map[string]schema.Attribute{
 			"team": schema.StringAttribute{
 				Description: "UUID for the Team for which to manage the permissions.",
@@ -53,10 +60,58 @@ func (*teamPermissionsResource) Schema(_ context.Context, _ resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"permissions": schema.ListAttribute{
-				Description: "Permissions for team. Conflicts with `dependencytrack_team_permission`. See DependencyTrack for allowed values.",
+			"permissions": schema.SetAttribute{
+				Description: "Permissions for team. Conflicts with `dependencytrack_team_permission`. Supports " + describeValues(permissionValues) + ".",
 				Required:    true,
 				ElementType: types.StringType,
+				Validators:  []validator.Set{setvalidator.ValueStringsAre(stringvalidator.OneOf(permissionValues...))},
+			},
+		},
+	}
+}
+
+var teamPermissionsIdentity = resourceIdentity{
+	{Name: "team_id", State: path.Root("team"), Description: "UUID of the Team."},
+}
+
+func (*teamPermissionsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamPermissionsIdentity.Schema()
+}
+
+func (*teamPermissionsResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		// Version 0 held `permissions` as a list.
+		0: {StateUpgrader: upgradeListsToSets},
+	}
+}
+
+func (*teamPermissionsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			// From a single `dependencytrack_team_permission`. Others for the team are added on the next apply.
+			SourceSchema: sourceSchema(ctx, NewTeamPermissionResource()),
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !isMoveFrom(req, resp, "dependencytrack_team_permission") {
+					return
+				}
+				var source teamPermissionResourceModel
+				resp.Diagnostics.Append(req.SourceState.Get(ctx, &source)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				target := teamPermissionsResourceModel{
+					TeamID:      source.TeamID,
+					Permissions: []types.String{source.Permission},
+				}
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &target)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(teamPermissionsIdentity.Set(ctx, resp.TargetState, resp.TargetIdentity)...)
+				tflog.Debug(ctx, "Moved Team Permission into Team Permissions", map[string]any{
+					"team":        target.TeamID.ValueString(),
+					"permissions": Map(target.Permissions, types.String.ValueString),
+				})
 			},
 		},
 	}
@@ -98,18 +153,10 @@ func (r *teamPermissionsResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	finalPermissionsStrings := Map(finalPermissions, func(permission dtrack.Permission) string {
+	statePermissions := Map(finalPermissions, func(permission dtrack.Permission) string {
 		return permission.Name
 	})
 
-	sortedDesiredPermissions := slices.SortedStableFunc(slices.Values(desiredPermissions), strings.Compare)
-	sortedFinalStringsPermissions := slices.SortedStableFunc(slices.Values(finalPermissionsStrings), strings.Compare)
-
-	var statePermissions = finalPermissionsStrings
-	if slices.Equal(sortedDesiredPermissions, sortedFinalStringsPermissions) {
-		statePermissions = desiredPermissions
-	}
-
 	plan = teamPermissionsResourceModel{
 		TeamID:      types.StringValue(teamID.String()),
 		Permissions: Map(statePermissions, types.StringValue),
@@ -120,6 +167,7 @@ func (r *teamPermissionsResource) Create(ctx context.Context, req resource.Creat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamPermissionsIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Team Permissions", map[string]any{
 		"team":        plan.TeamID.ValueString(),
 		"permissions": statePermissions,
@@ -127,6 +175,7 @@ func (r *teamPermissionsResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *teamPermissionsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(teamPermissionsIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state teamPermissionsResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -146,6 +195,9 @@ func (r *teamPermissionsResource) Read(ctx context.Context, req resource.ReadReq
 		"permissions.#": len(state.Permissions),
 	})
 	team, err := r.client.Team.Get(ctx, teamID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"team": teamID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated team",
@@ -154,19 +206,10 @@ func (r *teamPermissionsResource) Read(ctx context.Context, req resource.ReadReq
 		return
 	}
 
-	storedPermissionStrings := Map(state.Permissions, types.String.ValueString)
-	updatedPermissionStrings := Map(team.Permissions, func(permission dtrack.Permission) string {
+	statePermissions := Map(team.Permissions, func(permission dtrack.Permission) string {
 		return permission.Name
 	})
 
-	sortedUpdatedPermissions := slices.SortedStableFunc(slices.Values(updatedPermissionStrings), strings.Compare)
-	sortedStoredPermissions := slices.SortedStableFunc(slices.Values(storedPermissionStrings), strings.Compare)
-
-	statePermissions := updatedPermissionStrings
-	if slices.Equal(sortedUpdatedPermissions, sortedStoredPermissions) {
-		statePermissions = storedPermissionStrings
-	}
-
 	state = teamPermissionsResourceModel{
 		TeamID:      types.StringValue(teamID.String()),
 		Permissions: Map(statePermissions, types.StringValue),
@@ -214,7 +257,6 @@ func (r *teamPermissionsResource) Update(ctx context.Context, req resource.Updat
 
 	desiredPermissions := Map(plan.Permissions, func(desired types.String) string { return desired.ValueString() })
 	currentPermissions := Map(teamInfo.Permissions, func(current dtrack.Permission) string { return current.Name })
-	sortedDesiredPermissions := slices.SortedStableFunc(slices.Values(desiredPermissions), strings.Compare)
 
 	tflog.Debug(ctx, "Updating Team Permissions", map[string]any{
 		"current": currentPermissions,
@@ -226,13 +268,7 @@ func (r *teamPermissionsResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	finalPermissionStrings := Map(finalPermissions, func(permission dtrack.Permission) string { return permission.Name })
-	sortedFinalPermissions := slices.SortedStableFunc(slices.Values(finalPermissionStrings), strings.Compare)
-
-	statePermissions := finalPermissionStrings
-	if slices.Equal(sortedFinalPermissions, sortedDesiredPermissions) {
-		statePermissions = desiredPermissions
-	}
+	statePermissions := Map(finalPermissions, func(permission dtrack.Permission) string { return permission.Name })
 
 	plan = teamPermissionsResourceModel{
 		TeamID:      types.StringValue(team.String()),
@@ -245,6 +281,7 @@ func (r *teamPermissionsResource) Update(ctx context.Context, req resource.Updat
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamPermissionsIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Team Permissions", map[string]any{
 		"team":        plan.TeamID.ValueString(),
 		"permissions": finalPermissions,
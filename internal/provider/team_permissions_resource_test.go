@@ -5,6 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccTeamPermissionsResource(t *testing.T) {
@@ -31,8 +32,8 @@ resource "dependencytrack_team_permissions" "test" {
 						"dependencytrack_team.test", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.0", "BOM_UPLOAD"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.1", "SYSTEM_CONFIGURATION"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "BOM_UPLOAD"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "SYSTEM_CONFIGURATION"),
 				),
 			},
 			// Update and Read testing.
@@ -55,8 +56,8 @@ resource "dependencytrack_team_permissions" "test" {
 						"dependencytrack_team.test", "id",
 					),
 					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.0", "ACCESS_MANAGEMENT"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.1", "SYSTEM_CONFIGURATION"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "ACCESS_MANAGEMENT"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "SYSTEM_CONFIGURATION"),
 				),
 			},
 		},
@@ -84,8 +85,8 @@ resource "dependencytrack_team_permissions" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.0", "VIEW_PORTFOLIO"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.1", "BOM_UPLOAD"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "VIEW_PORTFOLIO"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "BOM_UPLOAD"),
 				),
 			},
 			// Read testing.
@@ -104,8 +105,8 @@ resource "dependencytrack_team_permissions" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.0", "VIEW_PORTFOLIO"),
-					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.1", "BOM_UPLOAD"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "VIEW_PORTFOLIO"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "BOM_UPLOAD"),
 				),
 				ConfigPlanChecks: resource.ConfigPlanChecks{
 					PreApply: []plancheck.PlanCheck{
@@ -144,3 +145,132 @@ resource "dependencytrack_team_permissions" "test" {
 		},
 	})
 }
+
+func TestAccTeamPermissionsResourceMoveState(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_team" "test" {
+	name = "Test_Team_Move"
+}
+resource "dependencytrack_team_permission" "test" {
+	team = dependencytrack_team.test.id
+	permission = "BOM_UPLOAD"
+}
+`,
+			},
+			// Move into plural resource, adding a permission.
+			{
+				Config: providerConfig + `
+resource "dependencytrack_team" "test" {
+	name = "Test_Team_Move"
+}
+resource "dependencytrack_team_permissions" "test" {
+	team = dependencytrack_team.test.id
+	permissions = [
+		"BOM_UPLOAD",
+		"VIEW_PORTFOLIO",
+	]
+}
+moved {
+	from = dependencytrack_team_permission.test
+	to = dependencytrack_team_permissions.test
+}
+`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_team_permissions.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "BOM_UPLOAD"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "VIEW_PORTFOLIO"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTeamPermissionsResourceMoveStateMultiple(t *testing.T) {
+	configMigrated := providerConfig + `
+resource "dependencytrack_team" "test" {
+	name = "Test_Team_Move_Multiple"
+}
+resource "dependencytrack_team_permissions" "test" {
+	team = dependencytrack_team.test.id
+	permissions = [
+		"BOM_UPLOAD",
+		"VIEW_PORTFOLIO",
+	]
+}
+moved {
+	from = dependencytrack_team_permission.bom_upload
+	to = dependencytrack_team_permissions.test
+}
+removed {
+	from = dependencytrack_team_permission.view_portfolio
+	lifecycle {
+		destroy = false
+	}
+}
+`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_team" "test" {
+	name = "Test_Team_Move_Multiple"
+}
+resource "dependencytrack_team_permission" "bom_upload" {
+	team = dependencytrack_team.test.id
+	permission = "BOM_UPLOAD"
+}
+resource "dependencytrack_team_permission" "view_portfolio" {
+	team = dependencytrack_team.test.id
+	permission = "VIEW_PORTFOLIO"
+}
+`,
+			},
+			// Move one into the plural resource, and remove the other without destroying it.
+			{
+				Config: configMigrated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("dependencytrack_team_permissions.test", plancheck.ResourceActionNoop),
+					},
+					PostApplyPreRefresh:  nil,
+					PostApplyPostRefresh: nil,
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "BOM_UPLOAD"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_team_permissions.test", "permissions.*", "VIEW_PORTFOLIO"),
+				),
+			},
+			// Both permissions remain on the team, so nothing is planned.
+			{
+				Config: configMigrated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+					PostApplyPreRefresh:  nil,
+					PostApplyPostRefresh: nil,
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_team_permissions.test", "permissions.#", "2"),
+				),
+			},
+		},
+	})
+}
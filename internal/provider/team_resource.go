@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -18,6 +19,7 @@ var (
 	_ resource.Resource                = &teamResource{}
 	_ resource.ResourceWithConfigure   = &teamResource{}
 	_ resource.ResourceWithImportState = &teamResource{}
+	_ resource.ResourceWithIdentity    = &teamResource{}
 )
 
 type (
@@ -59,6 +61,12 @@ func (*teamResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 	}
 }
 
+var teamIdentity = uuidIdentity("UUID of the Team.")
+
+func (*teamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamIdentity.Schema()
+}
+
 func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan teamResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -89,6 +97,7 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Team", map[string]any{
 		"id":   plan.ID,
 		"name": plan.Name,
@@ -96,6 +105,7 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(teamIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state teamResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -115,6 +125,9 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		"name": state.Name.ValueString(),
 	})
 	team, err := r.client.Team.Get(ctx, teamID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": teamID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated team",
@@ -181,6 +194,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(teamIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Team", map[string]any{
 		"id":   plan.ID.ValueString(),
 		"name": plan.Name.ValueString(),
@@ -224,16 +238,34 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	})
 }
 
-func (*teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := teamIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	tflog.Debug(ctx, "Importing Team", map[string]any{
-		"id": req.ID,
+		"id": importID,
+	})
+	id, err := ResolveImportID(importID, "<Name>", func(key string) ([]uuid.UUID, error) {
+		teams, err := FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.Team], error) {
+			return r.client.Team.GetAll(ctx, po)
+		}, func(team dtrack.Team) bool { return team.Name == key })
+		return Map(teams, func(team dtrack.Team) uuid.UUID { return team.UUID }), err
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve team.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 	tflog.Debug(ctx, "Imported Team", map[string]any{
-		"id": req.ID,
+		"id": id.String(),
 	})
 }
 
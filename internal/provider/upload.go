@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// Default duration to wait for an upload to be processed.
+	DefaultUploadTimeout = 5 * time.Minute
+	// Interval between checks of whether an upload is still being processed.
+	uploadPollInterval = 2 * time.Second
+)
+
+// Reads the document to upload, from either a file at path, or inline content.
+// Returns false when either is unknown, such as during plan.
+func readUploadContent(filePath types.String, content types.String) (string, bool, error) {
+	if filePath.IsUnknown() || content.IsUnknown() {
+		return "", false, nil
+	}
+	if !content.IsNull() {
+		return content.ValueString(), true, nil
+	}
+	if filePath.IsNull() {
+		return "", false, errors.New("one of 'path' or 'content' must be set")
+	}
+	data, err := os.ReadFile(filePath.ValueString())
+	if err != nil {
+		return "", false, err
+	}
+	return string(data), true, nil
+}
+
+func uploadContentHash(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// Polls DependencyTrack until the upload associated with token has been processed, or until timeout.
+func waitForUploadProcessing(ctx context.Context, client *dtrack.Client, semver *Semver, token string, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		var processing bool
+		var err error
+		if semver.Supports(CapabilityEventToken) {
+			processing, err = client.Event.IsBeingProcessed(ctx, dtrack.EventToken(token))
+		} else {
+			processing, err = client.BOM.IsBeingProcessed(ctx, dtrack.BOMUploadToken(token)) //nolint:staticcheck // Required for API before 4.11.
+		}
+		if err != nil {
+			return fmt.Errorf("unable to check processing status of token '%s': %w", token, err)
+		}
+		if !processing {
+			return nil
+		}
+		if time.Now().Add(uploadPollInterval).After(deadline) {
+			return fmt.Errorf("token '%s' was still being processed after %s", token, timeout)
+		}
+		tflog.Debug(ctx, "Waiting for upload to be processed", map[string]any{
+			"token": token,
+		})
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(uploadPollInterval):
+		}
+	}
+}
+
+// Plans `content_sha256` of the document to upload, so that changes to a file at `path` are shown in the plan.
+// When the hash is unchanged from state, `token` and the attributes within unknowns are kept from state.
+// Otherwise, as the document is uploaded again, they are planned as unknown, using the values within unknowns.
+// When validate is not nil, it is called with the content, so that invalid documents are reported during plan.
+func modifyUploadPlan(
+	ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse,
+	document string, validate func(content string) error, unknowns map[string]attr.Value,
+) {
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed.
+		return
+	}
+	var filePath, content, timeout types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("path"), &filePath)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content"), &content)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("timeout"), &timeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	parseDuration(timeout, DefaultUploadTimeout, path.Root("timeout"), &resp.Diagnostics)
+
+	hash := types.StringUnknown()
+	data, ok, err := readUploadContent(filePath, content)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		// File may be created during apply.
+	case err != nil:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("path"),
+			"Within ModifyPlan, unable to read "+document,
+			"Error from: "+err.Error(),
+		)
+		return
+	case ok:
+		if validate != nil {
+			if err = validate(data); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("content"),
+					"Within ModifyPlan, unable to parse "+document,
+					"Error from: "+err.Error(),
+				)
+				return
+			}
+		}
+		hash = types.StringValue(uploadContentHash(data))
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_sha256"), hash)...)
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var stateHash types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("content_sha256"), &stateHash)...)
+	computed := map[string]attr.Value{"token": types.StringUnknown()}
+	maps.Copy(computed, unknowns)
+	for name, value := range computed {
+		if hash.Equal(stateHash) {
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(name), &value)...)
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), value)...)
+	}
+}
+
+// Checks that the Project to which a document was uploaded still exists, removing the resource from state otherwise.
+// Uploaded documents cannot be retrieved as uploaded, so state is otherwise unchanged.
+func readUpload(ctx context.Context, client *dtrack.Client, req resource.ReadRequest, resp *resource.ReadResponse, document string) {
+	var project, token types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project"), &project)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("token"), &token)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Project "+document, map[string]any{
+		"project": project.ValueString(),
+	})
+	projectID, diagnostic := TryParseUUID(project, LifecycleRead, path.Root("project"))
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+	_, err := client.Project.Get(ctx, projectID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"project": projectID.String()}) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Read, unable to retrieve project",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Read Project "+document, map[string]any{
+		"project": project.ValueString(),
+		"token":   token.ValueString(),
+	})
+}
+
+// Removes an uploaded document from state only, as DependencyTrack retains the effects of processing it.
+func deleteUpload(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse, document string) {
+	var project types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("project"), &project)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Deleted Project "+document, map[string]any{
+		"project": project.ValueString(),
+	})
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestReadUploadContent(t *testing.T) {
+	{
+		content, ok, err := readUploadContent(types.StringNull(), types.StringValue(`{"bomFormat":"CycloneDX"}`))
+		requireNoError(t, err)
+		requireEqual(t, ok, true)
+		requireEqual(t, content, `{"bomFormat":"CycloneDX"}`)
+	}
+	{
+		filePath := filepath.Join(t.TempDir(), "bom.json")
+		requireNoError(t, os.WriteFile(filePath, []byte(`{"bomFormat":"CycloneDX"}`), 0o600))
+		content, ok, err := readUploadContent(types.StringValue(filePath), types.StringNull())
+		requireNoError(t, err)
+		requireEqual(t, ok, true)
+		requireEqual(t, uploadContentHash(content), "2e01b8740d2b1c7ff47487ab94ff0f7f4d2853f3d985cd6d7e381c4aa5ef9afa")
+	}
+	{
+		_, ok, err := readUploadContent(types.StringUnknown(), types.StringNull())
+		requireNoError(t, err)
+		requireEqual(t, ok, false)
+	}
+	{
+		_, _, err := readUploadContent(types.StringValue(filepath.Join(t.TempDir(), "missing.json")), types.StringNull())
+		requireError(t, err, "no such file or directory$")
+	}
+}
+
+func TestWaitForUploadProcessing(t *testing.T) {
+	processing := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/version":
+			_, _ = w.Write([]byte(`{"version":"4.13.0"}`))
+		case "/api/v1/event/token/c82d6f01-a7a4-41d6-9b03-4f06497f575b":
+			if processing {
+				_, _ = w.Write([]byte(`{"processing":true}`))
+			} else {
+				_, _ = w.Write([]byte(`{"processing":false}`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	client, err := dtrack.NewClient(server.URL)
+	requireNoError(t, err)
+	semver := &Semver{Major: 4, Minor: 13, Patch: 0}
+
+	err = waitForUploadProcessing(t.Context(), client, semver, "c82d6f01-a7a4-41d6-9b03-4f06497f575b", DefaultUploadTimeout)
+	requireNoError(t, err)
+
+	processing = true
+	err = waitForUploadProcessing(t.Context(), client, semver, "c82d6f01-a7a4-41d6-9b03-4f06497f575b", 0)
+	requireError(t, err, "^token 'c82d6f01-a7a4-41d6-9b03-4f06497f575b' was still being processed after 0s$")
+
+	err = waitForUploadProcessing(t.Context(), client, semver, "d82d6f01-a7a4-41d6-9b03-4f06497f575b", 0)
+	requireError(t, err, "^unable to check processing status of token 'd82d6f01-a7a4-41d6-9b03-4f06497f575b'")
+}
+
+func TestModifyUploadPlan(t *testing.T) {
+	ctx := t.Context()
+	var schemaResp resource.SchemaResponse
+	NewProjectVEXResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	resourceType := schemaResp.Schema.Type().TerraformType(ctx)
+	const content = `{"vulnerabilities":[{"id":"CVE-2021-44228"}]}`
+	analyses, diags := types.ListValueFrom(ctx, projectVEXAnalysisType, []projectVEXAnalysisModel{{
+		Component:     types.StringValue("c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Vulnerability: types.StringValue("d82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		VulnID:        types.StringValue("CVE-2021-44228"),
+		Source:        types.StringValue("NVD"),
+		State:         types.StringValue("NOT_AFFECTED"),
+		Suppressed:    types.BoolValue(false),
+	}})
+	requireEqual(t, diags.HasError(), false)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(resourceType, nil)}
+	diags = state.Set(ctx, projectVEXResourceModel{
+		Project:       types.StringValue("c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Path:          types.StringNull(),
+		Content:       types.StringValue(content),
+		ContentSHA256: types.StringValue(uploadContentHash(content)),
+		Token:         types.StringValue("e82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+		Timeout:       types.StringNull(),
+		Analyses:      analyses,
+	})
+	requireEqual(t, diags.HasError(), false)
+	modify := func(planContent string) (projectVEXResourceModel, resource.ModifyPlanResponse) {
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(resourceType, nil)}
+		planDiags := plan.Set(ctx, projectVEXResourceModel{
+			Project:       types.StringValue("c82d6f01-a7a4-41d6-9b03-4f06497f575b"),
+			Path:          types.StringNull(),
+			Content:       types.StringValue(planContent),
+			ContentSHA256: types.StringUnknown(),
+			Token:         types.StringUnknown(),
+			Timeout:       types.StringNull(),
+			Analyses:      types.ListUnknown(projectVEXAnalysisType),
+		})
+		requireEqual(t, planDiags.HasError(), false)
+		req := resource.ModifyPlanRequest{
+			Config:             tfsdk.Config{},
+			State:              state,
+			Plan:               plan,
+			ProviderMeta:       tfsdk.Config{},
+			Private:            nil,
+			ClientCapabilities: resource.ModifyPlanClientCapabilities{},
+		}
+		resp := resource.ModifyPlanResponse{
+			Plan:            plan,
+			RequiresReplace: nil,
+			Private:         nil,
+			Diagnostics:     nil,
+			Deferred:        nil,
+		}
+		modifyUploadPlan(ctx, req, &resp, "VEX", func(content string) error {
+			_, err := parseVEXVulnerabilities(content)
+			return err
+		}, map[string]attr.Value{
+			"analyses": types.ListUnknown(projectVEXAnalysisType),
+		})
+		var result projectVEXResourceModel
+		resp.Plan.Get(ctx, &result)
+		return result, resp
+	}
+
+	// Unchanged, so computed attributes are kept from state.
+	plan, resp := modify(content)
+	requireEqual(t, resp.Diagnostics.HasError(), false)
+	requireEqual(t, plan.ContentSHA256.ValueString(), uploadContentHash(content))
+	requireEqual(t, plan.Token.ValueString(), "e82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	requireEqual(t, plan.Analyses.Equal(analyses), true)
+
+	// Changed, so uploaded again.
+	const changed = `{"vulnerabilities":[{"id":"CVE-2022-22965"}]}`
+	plan, resp = modify(changed)
+	requireEqual(t, resp.Diagnostics.HasError(), false)
+	requireEqual(t, plan.ContentSHA256.ValueString(), uploadContentHash(changed))
+	requireEqual(t, plan.Token.IsUnknown(), true)
+	requireEqual(t, plan.Analyses.IsUnknown(), true)
+
+	// Invalid.
+	_, resp = modify("{")
+	requireEqual(t, resp.Diagnostics.HasError(), true)
+	requireEqual(t, resp.Diagnostics[0].Summary(), "Within ModifyPlan, unable to parse VEX")
+}
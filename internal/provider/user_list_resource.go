@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ list.ListResource              = &userListResource{}
+	_ list.ListResourceWithConfigure = &userListResource{}
+)
+
+type userListResource struct {
+	managed *userResource
+}
+
+func NewUserListResource() list.ListResource {
+	return &userListResource{managed: &userResource{}}
+}
+
+func (*userListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (*userListResource) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists all Managed Users, for `terraform query`.",
+		Attributes:  map[string]schema.Attribute{},
+	}
+}
+
+func (r *userListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	tflog.Debug(ctx, "Listing Managed Users")
+	users, err := dtrack.FetchAll(func(po dtrack.PageOptions) (dtrack.Page[dtrack.ManagedUser], error) {
+		return r.managed.client.User.GetAllManaged(ctx, po)
+	})
+	if err != nil {
+		var diags diag.Diagnostics
+		diags.AddError(
+			"Within List, unable to list managed users.",
+			"Error from: "+err.Error(),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	tflog.Debug(ctx, "Listed Managed Users", map[string]any{
+		"count": len(users),
+	})
+	streamListResults(ctx, req, stream, r.managed, userIdentity, users, func(user dtrack.ManagedUser) (string, string) {
+		return user.Username, user.Username
+	})
+}
+
+func (r *userListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.managed.Configure(ctx, req, resp)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ ephemeral.EphemeralResource              = &userLoginEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &userLoginEphemeralResource{}
+)
+
+type (
+	userLoginEphemeralResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	userLoginEphemeralResourceModel struct {
+		Token     types.String `tfsdk:"token"`
+		Username  types.String `tfsdk:"username"`
+		Password  types.String `tfsdk:"password"`
+		ExpiresAt types.String `tfsdk:"expires_at"`
+	}
+)
+
+func NewUserLoginEphemeralResource() ephemeral.EphemeralResource {
+	return &userLoginEphemeralResource{}
+}
+
+func (*userLoginEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_login"
+}
+
+func (*userLoginEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Authenticate as a Managed User, without persisting the token to state. Requires Terraform 1.10+.",
+		Attributes: map[string]schema.Attribute{
+			"token": schema.StringAttribute{
+				Description: "DependencyTrack bearer token.",
+				Sensitive:   true,
+				Computed:    true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username of the Managed User.",
+				Required:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password of the Managed User.",
+				Sensitive:   true,
+				Required:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Expiry of the token, in RFC 3339 format. Null if the token does not declare an expiry.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *userLoginEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config userLoginEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := config.Username.ValueString()
+	tflog.Debug(ctx, "Authenticating as Managed User", map[string]any{
+		"username": username,
+	})
+	token, err := r.client.User.Login(ctx, username, config.Password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Open, unable to Login as user: "+username,
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	result := userLoginEphemeralResourceModel{
+		Token:     types.StringValue(token),
+		Username:  config.Username,
+		Password:  config.Password,
+		ExpiresAt: types.StringNull(),
+	}
+	if expiry := jwtExpiry(token); !expiry.IsZero() {
+		result.ExpiresAt = types.StringValue(expiry.Format(time.RFC3339))
+	}
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Authenticated as Managed User", map[string]any{
+		"username":   username,
+		"expires_at": result.ExpiresAt.ValueString(),
+	})
+}
+
+func (r *userLoginEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
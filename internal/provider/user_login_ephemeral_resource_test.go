@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserLoginEphemeralResource(t *testing.T) {
+	username, password := os.Getenv(EnvUsername), os.Getenv(EnvPassword)
+	if username == "" || password == "" {
+		t.Skip("Requires " + EnvUsername + " and " + EnvPassword + " environment variables.")
+	}
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactoriesWithEcho,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "dependencytrack_user_login" "test" {
+	username = "` + username + `"
+	password = "` + password + `"
+}
+provider "echo" {
+	data = ephemeral.dependencytrack_user_login.test
+}
+resource "echo" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("echo.test", "data.token"),
+					resource.TestCheckResourceAttr("echo.test", "data.username", username),
+				),
+			},
+		},
+	})
+}
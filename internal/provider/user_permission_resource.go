@@ -5,10 +5,13 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -16,6 +19,7 @@ import (
 var (
 	_ resource.Resource              = &userPermissionResource{}
 	_ resource.ResourceWithConfigure = &userPermissionResource{}
+	_ resource.ResourceWithIdentity  = &userPermissionResource{}
 )
 
 type (
@@ -50,16 +54,26 @@ func (*userPermissionResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"permission": schema.StringAttribute{
-				Description: "Permission name to attach to the User.",
+				Description: "Permission name to attach to the User. Supports " + describeValues(permissionValues) + ".",
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+				Validators: []validator.String{stringvalidator.OneOf(permissionValues...)},
 			},
 		},
 	}
 }
 
+var userPermissionIdentity = resourceIdentity{
+	{Name: "username", State: path.Root("username"), Description: "Username of the User."},
+	{Name: "permission", State: path.Root("permission"), Description: "Name of the Permission."},
+}
+
+func (*userPermissionResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = userPermissionIdentity.Schema()
+}
+
 func (r *userPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userPermissionResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -94,6 +108,7 @@ func (r *userPermissionResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userPermissionIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created User Permission", map[string]any{
 		"username":   state.Username.ValueString(),
 		"permission": state.Permission.ValueString(),
@@ -101,6 +116,7 @@ func (r *userPermissionResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *userPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(userPermissionIdentity.Set(ctx, req.State, resp.Identity)...)
 	// Fetch state.
 	var state userPermissionResourceModel
 	diags := req.State.Get(ctx, &state)
@@ -116,6 +132,9 @@ func (r *userPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 		"permission": state.Permission.ValueString(),
 	})
 	user, err := FindUserPrincipal(ctx, *r.client, username)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"username": username, "permission": state.Permission.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to get updated user",
@@ -126,6 +145,9 @@ func (r *userPermissionResource) Read(ctx context.Context, req resource.ReadRequ
 	permission, err := Find(user.Permissions, func(permission dtrack.Permission) bool {
 		return permission.Name == state.Permission.ValueString()
 	})
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"username": username, "permission": state.Permission.ValueString()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to identify user permission",
@@ -166,6 +188,7 @@ func (*userPermissionResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userPermissionIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated User Permission", map[string]any{
 		"username":   plan.Username.ValueString(),
 		"permission": plan.Permission.ValueString(),
@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -18,6 +21,7 @@ var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithIdentity    = &userResource{}
 )
 
 type (
@@ -32,6 +36,8 @@ type (
 		Fullname            types.String `tfsdk:"fullname"`
 		Email               types.String `tfsdk:"email"`
 		Password            types.String `tfsdk:"password"`
+		PasswordWO          types.String `tfsdk:"password_wo"`
+		PasswordWOVersion   types.Int32  `tfsdk:"password_wo_version"`
 		Suspended           types.Bool   `tfsdk:"suspended"`
 		ForcePasswordChange types.Bool   `tfsdk:"force_password_change"`
 		PasswordExpires     types.Bool   `tfsdk:"password_expires"`
@@ -88,7 +94,7 @@ func (*userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 				Computed:    true,
 			},
 			"password": schema.StringAttribute{
-				Description: "Updated password to set for the User.",
+				Description: "Updated password to set for the User. Stored in state, prefer `password_wo`.",
 				Sensitive:   true,
 				Computed:    true,
 				Optional:    true,
@@ -96,10 +102,34 @@ func (*userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"password_wo": schema.StringAttribute{
+				Description: "Password to set for the User, which is never stored in state or plan. " +
+					"Only set on create, or when `password_wo_version` changes. Requires Terraform 1.11+.",
+				Sensitive: true,
+				WriteOnly: true,
+				Optional:  true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password")),
+					stringvalidator.AlsoRequires(path.MatchRoot("password_wo_version")),
+				},
+			},
+			"password_wo_version": schema.Int32Attribute{
+				Description: "Version of `password_wo`. Change to set the current value of `password_wo` for the User.",
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.AlsoRequires(path.MatchRoot("password_wo"))},
+			},
 		},
 	}
 }
 
+var userIdentity = resourceIdentity{
+	{Name: "username", State: path.Root("id"), Description: "Username of the Managed User."},
+}
+
+func (*userResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = userIdentity.Schema()
+}
+
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -108,13 +138,20 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	if plan.Password.IsUnknown() || plan.Password.IsNull() {
+	password := GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "password_wo")
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if password == "" && (plan.Password.IsUnknown() || plan.Password.IsNull()) {
 		resp.Diagnostics.AddError(
 			"Missing required password field",
-			"Password is required when creating a managed user.",
+			"Either password or password_wo is required when creating a managed user.",
 		)
 		return
 	}
+	if password == "" {
+		password = plan.Password.ValueString()
+	}
 
 	userReq := dtrack.ManagedUser{
 		Username:            plan.Username.ValueString(),
@@ -123,8 +160,8 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Suspended:           plan.Suspended.ValueBool(),
 		ForcePasswordChange: plan.ForcePasswordChange.ValueBool(),
 		NonExpiryPassword:   !plan.PasswordExpires.ValueBool(),
-		NewPassword:         plan.Password.ValueString(),
-		ConfirmPassword:     plan.Password.ValueString(),
+		NewPassword:         password,
+		ConfirmPassword:     password,
 	}
 
 	tflog.Debug(ctx, "Creating Managed User", map[string]any{
@@ -134,7 +171,6 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		"suspended":             userReq.Suspended,
 		"force_password_change": userReq.ForcePasswordChange,
 		"non_expiry_password":   userReq.NonExpiryPassword,
-		"password":              userReq.NewPassword,
 	})
 
 	userRes, err := r.client.User.CreateManaged(ctx, userReq)
@@ -152,16 +188,22 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Fullname:            types.StringValue(userRes.Fullname),
 		Email:               types.StringValue(userRes.Email),
 		Password:            types.StringValue(userReq.NewPassword),
+		PasswordWO:          types.StringNull(),
+		PasswordWOVersion:   plan.PasswordWOVersion,
 		Suspended:           types.BoolValue(userRes.Suspended),
 		ForcePasswordChange: types.BoolValue(userRes.ForcePasswordChange),
 		PasswordExpires:     types.BoolValue(!userRes.NonExpiryPassword),
 	}
+	if !plan.PasswordWOVersion.IsNull() {
+		plan.Password = types.StringNull()
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created Managed User", map[string]any{
 		"id":                    plan.ID.ValueString(),
 		"username":              plan.Username.ValueString(),
@@ -175,6 +217,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(userIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state userResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -193,6 +236,9 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			return user.Username == username
 		},
 	)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": username}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read managed user",
@@ -206,6 +252,8 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		Fullname:            types.StringValue(user.Fullname),
 		Email:               types.StringValue(user.Email),
 		Password:            state.Password,
+		PasswordWO:          types.StringNull(),
+		PasswordWOVersion:   state.PasswordWOVersion,
 		Suspended:           types.BoolValue(user.Suspended),
 		ForcePasswordChange: types.BoolValue(user.ForcePasswordChange),
 		PasswordExpires:     types.BoolValue(!user.NonExpiryPassword),
@@ -250,6 +298,13 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		ForcePasswordChange: plan.ForcePasswordChange.ValueBool(),
 		NonExpiryPassword:   !plan.PasswordExpires.ValueBool(),
 	}
+	if !plan.PasswordWOVersion.Equal(state.PasswordWOVersion) {
+		userReq.NewPassword = GetWriteOnly(ctx, &resp.Diagnostics, req.Config, "password_wo")
+		userReq.ConfirmPassword = userReq.NewPassword
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	// Execute.
 	tflog.Debug(ctx, "Updating Managed User", map[string]any{
@@ -276,6 +331,8 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		Fullname:            types.StringValue(userRes.Fullname),
 		Email:               types.StringValue(userRes.Email),
 		Password:            state.Password,
+		PasswordWO:          types.StringNull(),
+		PasswordWOVersion:   plan.PasswordWOVersion,
 		Suspended:           types.BoolValue(userRes.Suspended),
 		ForcePasswordChange: types.BoolValue(userRes.ForcePasswordChange),
 		PasswordExpires:     types.BoolValue(!userRes.NonExpiryPassword),
@@ -287,6 +344,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Updated Managed User", map[string]any{
 		"id":                    state.ID.ValueString(),
 		"username":              state.Username.ValueString(),
@@ -348,7 +406,7 @@ func (*userResource) ImportState(ctx context.Context, req resource.ImportStateRe
 	tflog.Debug(ctx, "Importing Managed User", map[string]any{
 		"id": req.ID,
 	})
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	userIdentity.ImportStatePassthrough(ctx, req, resp)
 	if resp.Diagnostics.HasError() {
 		return
 	}
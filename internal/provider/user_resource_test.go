@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 func TestAccUserResource(t *testing.T) {
@@ -62,3 +64,61 @@ resource "dependencytrack_user" "test" {
 		},
 	})
 }
+
+func TestAccUserResourceWriteOnly(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_user" "test" {
+	username = "Test_Username_WriteOnly"
+	fullname = "Test_Fullname"
+	email = "Test_Email@example.com"
+	password_wo = "Test_Password"
+	password_wo_version = 1
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_user.test", "id", "Test_Username_WriteOnly"),
+					resource.TestCheckNoResourceAttr("dependencytrack_user.test", "password"),
+					resource.TestCheckNoResourceAttr("dependencytrack_user.test", "password_wo"),
+					resource.TestCheckResourceAttr("dependencytrack_user.test", "password_wo_version", "1"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_user" "test" {
+	username = "Test_Username_WriteOnly"
+	fullname = "Test_Fullname"
+	email = "Test_Email@example.com"
+	password_wo = "Test_Password_With_Change"
+	password_wo_version = 2
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("dependencytrack_user.test", "password"),
+					resource.TestCheckNoResourceAttr("dependencytrack_user.test", "password_wo"),
+					resource.TestCheckResourceAttr("dependencytrack_user.test", "password_wo_version", "2"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "dependencytrack_user" "test" {
+	username = "Test_Username_WriteOnly"
+	fullname = "Test_Fullname"
+	email = "Test_Email@example.com"
+	password = "Test_Password"
+	password_wo = "Test_Password"
+	password_wo_version = 3
+}
+`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute "password" cannot be specified when "password_wo" is specified`),
+			},
+		},
+	})
+}
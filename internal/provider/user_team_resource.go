@@ -17,6 +17,7 @@ import (
 var (
 	_ resource.Resource              = &userTeamResource{}
 	_ resource.ResourceWithConfigure = &userTeamResource{}
+	_ resource.ResourceWithIdentity  = &userTeamResource{}
 )
 
 type (
@@ -61,6 +62,15 @@ func (*userTeamResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+var userTeamIdentity = resourceIdentity{
+	{Name: "username", State: path.Root("username"), Description: "Username of the User."},
+	{Name: "team_id", State: path.Root("team"), Description: "UUID of the Team."},
+}
+
+func (*userTeamResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = userTeamIdentity.Schema()
+}
+
 func (r *userTeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan userTeamResourceModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -98,6 +108,7 @@ func (r *userTeamResource) Create(ctx context.Context, req resource.CreateReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userTeamIdentity.Set(ctx, resp.State, resp.Identity)...)
 	tflog.Debug(ctx, "Created User Team Membership", map[string]any{
 		"username": state.Username.ValueString(),
 		"team":     state.TeamID.ValueString(),
@@ -105,6 +116,7 @@ func (r *userTeamResource) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *userTeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(userTeamIdentity.Set(ctx, req.State, resp.Identity)...)
 	var state userTeamResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -123,6 +135,9 @@ func (r *userTeamResource) Read(ctx context.Context, req resource.ReadRequest, r
 		"team":     teamID.String(),
 	})
 	user, err := FindUserPrincipal(ctx, *r.client, username)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"username": username, "team": teamID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read user",
@@ -131,6 +146,9 @@ func (r *userTeamResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 	team, err := Find(user.Teams, func(team dtrack.Team) bool { return team.UUID == teamID })
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"username": username, "team": teamID.String()}) {
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Within Read, unable to identify user team membership",
@@ -169,6 +187,7 @@ func (*userTeamResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	resp.Diagnostics.Append(userTeamIdentity.Set(ctx, resp.State, resp.Identity)...)
 
 	tflog.Debug(ctx, "Updated User Team Membership", map[string]any{
 		"username": plan.Username.ValueString(),
@@ -5,15 +5,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
 	"strconv"
 	"strings"
 
 	dtrack "github.com/DependencyTrack/client-go"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 const (
@@ -25,8 +29,12 @@ const (
 	LifecycleUpdate LifecycleAction = "Update"
 	LifecycleDelete LifecycleAction = "Delete"
 	LifecycleImport LifecycleAction = "Import"
+	LifecycleOpen   LifecycleAction = "Open"
 )
 
+// ErrNotFound is returned when Find, FindPaged, or similar, do not find a matching item.
+var ErrNotFound = errors.New("did not find item")
+
 type (
 	Semver struct {
 		Major int
@@ -42,6 +50,24 @@ type (
 	LifecycleAction string
 )
 
+// IsNotFound returns whether err is from a 404 response from DependencyTrack API, or from not finding a matching item.
+func IsNotFound(err error) bool {
+	var apiErr *dtrack.APIError
+	return errors.Is(err, ErrNotFound) || (errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound)
+}
+
+// RemoveIfNotFound removes the resource from state when err is IsNotFound, such as when deleted outside of Terraform,
+// so that it is planned to be recreated. Returns whether the resource was removed.
+func RemoveIfNotFound(ctx context.Context, err error, state *tfsdk.State, fields map[string]any) bool {
+	if !IsNotFound(err) {
+		return false
+	}
+	fields["error"] = err.Error()
+	tflog.Warn(ctx, "Resource not found within DependencyTrack, removing from state", fields)
+	state.RemoveResource(ctx)
+	return true
+}
+
 func Filter[T any](items []T, filter func(T) bool) []T {
 	filtered := make([]T, 0, len(items))
 	for _, item := range items {
@@ -56,7 +82,7 @@ func Filter[T any](items []T, filter func(T) bool) []T {
 func Find[T any](items []T, filter func(T) bool) (*T, error) {
 	filtered := Filter(items, filter)
 	if len(filtered) == 0 {
-		return nil, errors.New("did not find item")
+		return nil, ErrNotFound
 	} else if len(filtered) > 1 {
 		return nil, errors.New("found multiple items")
 	}
@@ -90,7 +116,7 @@ func FindPaged[T any](
 		return nil, err
 	}
 	if len(filtered) == 0 {
-		return nil, errors.New("did not find item")
+		return nil, ErrNotFound
 	} else if len(filtered) > 1 {
 		return nil, errors.New("found multiple items")
 	}
@@ -153,8 +179,9 @@ func FindPagedPolicyCondition(
 	return condition, nil
 }
 
+// ParseSemver parses a 'major.minor.patch' version, ignoring any pre-release or build suffix, such as '4.13.0-SNAPSHOT'.
 func ParseSemver(s string) (*Semver, error) {
-	parts := strings.Split(s, ".")
+	parts := strings.Split(trimSemverSuffix(s), ".")
 	if len(parts) != SemverComponentCount {
 		return nil, fmt.Errorf("found semver with %v parts, expected 3", len(parts))
 	}
@@ -187,6 +214,29 @@ func ParseSemver(s string) (*Semver, error) {
 	return &semver, nil
 }
 
+// Removes build metadata after '+', and pre-release after a '-' within the patch component.
+func trimSemverSuffix(s string) string {
+	version, _, _ := strings.Cut(s, "+")
+	parts := strings.SplitN(version, ".", SemverComponentCount)
+	if len(parts) != SemverComponentCount || len(parts[2]) <= 1 {
+		return version
+	}
+	// Leading '-' of the patch component is a negative number, rather than a pre-release.
+	if index := strings.Index(parts[2][1:], "-"); index >= 0 {
+		parts[2] = parts[2][:index+1]
+	}
+	return strings.Join(parts, ".")
+}
+
+// Compare returns -1, 0, or 1, when s is less than, equal to, or greater than other.
+func (s Semver) Compare(other Semver) int {
+	return cmp.Or(cmp.Compare(s.Major, other.Major), cmp.Compare(s.Minor, other.Minor), cmp.Compare(s.Patch, other.Patch))
+}
+
+func (s Semver) String() string {
+	return fmt.Sprintf("%d.%d.%d", s.Major, s.Minor, s.Patch)
+}
+
 func ListDeltas[T cmp.Ordered](current []T, desired []T) (add []T, remove []T) {
 	add = []T{}
 	remove = []T{}
@@ -262,7 +312,15 @@ func TryParseUUID(value types.String, action LifecycleAction, tfPath path.Path)
 	return ret, nil
 }
 
-func GetStringList(ctx context.Context, diags *diag.Diagnostics, list types.List) ([]string, error) {
+// Either a types.List or types.Set, of strings.
+type stringCollection interface {
+	IsNull() bool
+	IsUnknown() bool
+	Elements() []attr.Value
+	ElementsAs(ctx context.Context, target any, allowUnhandled bool) diag.Diagnostics
+}
+
+func GetStringList(ctx context.Context, diags *diag.Diagnostics, list stringCollection) ([]string, error) {
 	if list.IsUnknown() || list.IsNull() {
 		return []string{}, nil
 	}
@@ -284,6 +342,13 @@ func GetStringList(ctx context.Context, diags *diag.Diagnostics, list types.List
 	return stringList, err
 }
 
+// GetWriteOnly returns the value of a write-only attribute, which is only present within config, and is always null within plan and state.
+func GetWriteOnly(ctx context.Context, diags *diag.Diagnostics, config tfsdk.Config, attribute string) string {
+	var value types.String
+	diags.Append(config.GetAttribute(ctx, path.Root(attribute), &value)...)
+	return value.ValueString()
+}
+
 func SliceUnorderedEqual[T any](a, b []T, compare func(a, b T) int) bool {
 	sortedA := slices.SortedStableFunc(slices.Values(a), compare)
 	sortedB := slices.SortedStableFunc(slices.Values(b), compare)
@@ -356,5 +421,5 @@ func FindUserPrincipal(ctx context.Context, client dtrack.Client, username strin
 		}, nil
 	}
 	// Not found.
-	return nil, errors.New("could not find user")
+	return nil, fmt.Errorf("could not find user %s: %w", username, ErrNotFound)
 }
@@ -2,9 +2,17 @@ package provider
 
 import (
 	"cmp"
+	"errors"
+	"fmt"
+	"net/http"
 	"regexp"
 	"strings"
 	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestParseSemver(t *testing.T) {
@@ -62,6 +70,74 @@ func TestParseSemver(t *testing.T) {
 		requireError(t, err, "^unable to validate semver patch component, from: -3$")
 		requireNil(t, semver)
 	}
+	{
+		semver, err := ParseSemver("4.13.0-SNAPSHOT")
+		requireNoError(t, err)
+		requireEqual(t, semver.String(), "4.13.0")
+	}
+	{
+		semver, err := ParseSemver("4.12.7-rc.1+build-5")
+		requireNoError(t, err)
+		requireEqual(t, semver.String(), "4.12.7")
+	}
+	{
+		semver, err := ParseSemver("5.0.0+20240101")
+		requireNoError(t, err)
+		requireEqual(t, semver.String(), "5.0.0")
+	}
+	{
+		semver, err := ParseSemver("1.2-SNAPSHOT")
+		requireError(t, err, "^found semver with 2 parts, expected 3$")
+		requireNil(t, semver)
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	base := Semver{Major: 4, Minor: 12, Patch: 3}
+	requireEqual(t, base.Compare(Semver{Major: 4, Minor: 12, Patch: 3}), 0)
+	requireEqual(t, base.Compare(Semver{Major: 4, Minor: 12, Patch: 4}), -1)
+	requireEqual(t, base.Compare(Semver{Major: 4, Minor: 11, Patch: 9}), 1)
+	requireEqual(t, base.Compare(Semver{Major: 5, Minor: 0, Patch: 0}), -1)
+	requireEqual(t, base.Compare(Semver{Major: 3, Minor: 20, Patch: 0}), 1)
+}
+
+func TestIsNotFound(t *testing.T) {
+	requireEqual(t, IsNotFound(nil), false)
+	requireEqual(t, IsNotFound(&dtrack.APIError{StatusCode: http.StatusNotFound, Message: ""}), true)
+	requireEqual(t, IsNotFound(fmt.Errorf("wrapped: %w", &dtrack.APIError{StatusCode: http.StatusNotFound, Message: ""})), true)
+	requireEqual(t, IsNotFound(&dtrack.APIError{StatusCode: http.StatusForbidden, Message: ""}), false)
+	requireEqual(t, IsNotFound(errors.New("did not find item")), false)
+	_, err := Find([]int{1, 2}, func(i int) bool { return i == 3 })
+	requireEqual(t, IsNotFound(err), true)
+	_, err = Find([]int{1, 1}, func(i int) bool { return i == 1 })
+	requireEqual(t, IsNotFound(err), false)
+}
+
+func TestRemoveIfNotFound(t *testing.T) {
+	newState := func() tfsdk.State {
+		return tfsdk.State{
+			Schema: schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}}},
+			Raw: tftypes.NewValue(
+				tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+				map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "id")},
+			),
+		}
+	}
+	{
+		state := newState()
+		requireEqual(t, RemoveIfNotFound(t.Context(), &dtrack.APIError{StatusCode: http.StatusNotFound, Message: ""}, &state, map[string]any{}), true)
+		requireEqual(t, state.Raw.IsNull(), true)
+	}
+	{
+		state := newState()
+		requireEqual(t, RemoveIfNotFound(t.Context(), &dtrack.APIError{StatusCode: http.StatusInternalServerError, Message: ""}, &state, map[string]any{}), false)
+		requireEqual(t, state.Raw.IsNull(), false)
+	}
+	{
+		state := newState()
+		requireEqual(t, RemoveIfNotFound(t.Context(), nil, &state, map[string]any{}), false)
+		requireEqual(t, state.Raw.IsNull(), false)
+	}
 }
 
 func TestSliceUnorderedEqual(t *testing.T) {
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Valid values of enumerated attributes. Built from the DependencyTrack client constants, where it defines them.
+var (
+	// Classifiers of a Project or Component.
+	classifierValues = []string{
+		"APPLICATION", "FRAMEWORK", "LIBRARY", "CONTAINER", "PLATFORM", "OPERATING_SYSTEM", "DEVICE",
+		"DEVICE_DRIVER", "FIRMWARE", "FILE", "MACHINE_LEARNING_MODEL", "DATA", "CRYPTOGRAPHIC_ASSET",
+	}
+	collectionLogicValues = enumValues(
+		dtrack.CollectionLogicNone,
+		dtrack.CollectionLogicAggregateDirectChildren,
+		dtrack.CollectionLogicAggregateDirectChildrenWithTag,
+		dtrack.CollectionLogicAggregateLatestVersionChildren,
+	)
+	policyOperatorValues       = enumValues(dtrack.PolicyOperatorAll, dtrack.PolicyOperatorAny)
+	policyViolationStateValues = enumValues(
+		dtrack.PolicyViolationStateInfo,
+		dtrack.PolicyViolationStateWarn,
+		dtrack.PolicyViolationStateFail,
+	)
+	policyConditionSubjectValues = enumValues(
+		dtrack.PolicyConditionSubjectAge,
+		dtrack.PolicyConditionSubjectCoordinates,
+		dtrack.PolicyConditionSubjectCPE,
+		dtrack.PolicyConditionSubjectLicense,
+		dtrack.PolicyConditionSubjectLicenseGroup,
+		dtrack.PolicyConditionSubjectPackageURL,
+		dtrack.PolicyConditionSubjectSeverity,
+		dtrack.PolicyConditionSubjectSWIDTagID,
+		dtrack.PolicyConditionSubjectVersion,
+		dtrack.PolicyConditionSubjectComponentHash,
+		dtrack.PolicyConditionSubjectCWE,
+		dtrack.PolicyConditionSubjectVulnerabilityID,
+	)
+	policyConditionOperatorValues = enumValues(
+		dtrack.PolicyConditionOperatorIs,
+		dtrack.PolicyConditionOperatorIsNot,
+		dtrack.PolicyConditionOperatorMatches,
+		dtrack.PolicyConditionOperatorNoMatch,
+		dtrack.PolicyConditionOperatorNumericGreaterThan,
+		dtrack.PolicyConditionOperatorNumericLessThan,
+		dtrack.PolicyConditionOperatorNumericEqual,
+		dtrack.PolicyConditionOperatorNumericNotEqual,
+		dtrack.PolicyConditionOperatorNumericGreaterThanOrEqual,
+		dtrack.PolicyConditionOperatorNumericLesserThanOrEqual,
+		dtrack.PolicyConditionOperatorContainsAll,
+		dtrack.PolicyConditionOperatorContainsAny,
+	)
+	repositoryTypeValues = []string{
+		dtrack.RepositoryTypeCargo,
+		dtrack.RepositoryTypeComposer,
+		dtrack.RepositoryTypeCpan,
+		dtrack.RepositoryTypeGem,
+		dtrack.RepositoryTypeGithub,
+		dtrack.RepositoryTypeGoModules,
+		dtrack.RepositoryTypeHex,
+		dtrack.RepositoryTypeMaven,
+		dtrack.RepositoryTypeNpm,
+		dtrack.RepositoryTypeNuget,
+		dtrack.RepositoryTypePypi,
+		dtrack.RepositoryTypeUnsupported,
+	}
+	permissionValues = []string{
+		dtrack.PermissionAccessManagement,
+		dtrack.PermissionBOMUpload,
+		dtrack.PermissionPolicyManagement,
+		dtrack.PermissionPolicyViolationAnalysis,
+		dtrack.PermissionPortfolioManagement,
+		dtrack.PermissionProjectCreationUpload,
+		dtrack.PermissionSystemConfiguration,
+		dtrack.PermissionTagManagement,
+		dtrack.PermissionViewBadges,
+		dtrack.PermissionViewPolicyViolation,
+		dtrack.PermissionViewPortfolio,
+		dtrack.PermissionViewVulnerability,
+		dtrack.PermissionVulnerabilityAnalysis,
+		dtrack.PermissionVulnerabilityManagement,
+	}
+	// Types of a Config, Project, or Component Property.
+	propertyTypeValues = []string{
+		"BOOLEAN", "INTEGER", "NUMBER", "STRING", PropertyTypeEncryptedString, "TIMESTAMP", "URL", "UUID",
+	}
+	notificationScopeValues = enumValues(dtrack.NotificationRuleScopePortfolio, dtrack.NotificationRuleScopeSystem)
+	notificationLevelValues = enumValues(
+		dtrack.NotificationRuleLevelInformational,
+		dtrack.NotificationRuleLevelWarning,
+		dtrack.NotificationRuleLevelError,
+	)
+	notificationTriggerTypeValues = enumValues(
+		dtrack.NotificationRuleTriggerTypeEvent,
+		dtrack.NotificationRuleTriggerTypeSchedule,
+	)
+	analysisStateValues = enumValues(
+		dtrack.AnalysisStateExploitable,
+		dtrack.AnalysisStateFalsePositive,
+		dtrack.AnalysisStateInTriage,
+		dtrack.AnalysisStateNotAffected,
+		dtrack.AnalysisStateNotSet,
+		dtrack.AnalysisStateResolved,
+	)
+	analysisJustificationValues = enumValues(
+		dtrack.AnalysisJustificationCodeNotPresent,
+		dtrack.AnalysisJustificationCodeNotReachable,
+		dtrack.AnalysisJustificationNotSet,
+		dtrack.AnalysisJustificationProtectedAtPerimeter,
+		dtrack.AnalysisJustificationProtectedAtRuntime,
+		dtrack.AnalysisJustificationProtectedByCompiler,
+		dtrack.AnalysisJustificationProtectedByMitigatingControl,
+		dtrack.AnalysisJustificationRequiresConfiguration,
+		dtrack.AnalysisJustificationRequiresDependency,
+		dtrack.AnalysisJustificationRequiresEnvironment,
+	)
+	analysisResponseValues = enumValues(
+		dtrack.AnalysisResponseCanNotFix,
+		dtrack.AnalysisResponseNotSet,
+		dtrack.AnalysisResponseRollback,
+		dtrack.AnalysisResponseUpdate,
+		dtrack.AnalysisResponseWillNotFix,
+		dtrack.AnalysisResponseWorkaroundAvailable,
+	)
+	violationAnalysisStateValues = enumValues(
+		dtrack.ViolationAnalysisStateApproved,
+		dtrack.ViolationAnalysisStateRejected,
+		dtrack.ViolationAnalysisStateNotSet,
+	)
+	vulnerabilitySeverityValues = []string{"CRITICAL", "HIGH", "MEDIUM", "LOW", "INFO", "UNASSIGNED"}
+	// Identifiers of a component affected by a vulnerability.
+	affectedIdentityTypeValues = []string{"PURL", "CPE"}
+	// Notification groups, for 'notify_on'.
+	notificationGroupValues = []string{
+		"CONFIGURATION", "DATASOURCE_MIRRORING", "REPOSITORY", "INTEGRATION", "INDEXING_SERVICE", "FILE_SYSTEM",
+		"ANALYZER", "NEW_VULNERABILITY", "NEW_VULNERABLE_DEPENDENCY", "PROJECT_AUDIT_CHANGE", "BOM_CONSUMED",
+		"BOM_PROCESSED", "BOM_PROCESSING_FAILED", "BOM_VALIDATION_FAILED", "VEX_CONSUMED", "VEX_PROCESSED",
+		"POLICY_VIOLATION", "PROJECT_CREATED", "USER_CREATED", "USER_DELETED",
+		"NEW_VULNERABILITIES_SUMMARY", "NEW_POLICY_VIOLATIONS_SUMMARY",
+	}
+)
+
+func enumValues[T ~string](values ...T) []string {
+	return Map(values, func(value T) string { return string(value) })
+}
+
+// Formats values for an attribute description, such as `"A"`, `"B"`, or `"C"`.
+func describeValues(values []string) string {
+	quoted := Map(values, func(value string) string { return fmt.Sprintf("%q", value) })
+	if len(quoted) <= 1 {
+		return strings.Join(quoted, "")
+	}
+	return strings.Join(quoted[:len(quoted)-1], ", ") + ", or " + quoted[len(quoted)-1]
+}
+
+// Validates that, when an attribute is configured, another attribute is configured with one of the given values.
+// Used for attributes which are only relevant for a certain value of another, such as 'schedule_cron' with 'trigger_type'.
+type attributeRequiresValueValidator struct {
+	attribute path.Path
+	other     path.Path
+	values    []string
+}
+
+var _ resource.ConfigValidator = attributeRequiresValueValidator{}
+
+func attributeRequiresValue(attribute, other path.Path, values ...string) attributeRequiresValueValidator {
+	return attributeRequiresValueValidator{attribute: attribute, other: other, values: values}
+}
+
+func (v attributeRequiresValueValidator) Description(_ context.Context) string {
+	return fmt.Sprintf("Attribute '%s' requires '%s' to be %s.", v.attribute, v.other, describeValues(v.values))
+}
+
+func (v attributeRequiresValueValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v attributeRequiresValueValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var attribute attr.Value
+	var other types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.attribute, &attribute)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, v.other, &other)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if attribute.IsNull() || other.IsUnknown() {
+		return
+	}
+	if !other.IsNull() && slices.Contains(v.values, other.ValueString()) {
+		return
+	}
+	resp.Diagnostics.AddAttributeError(
+		v.attribute,
+		"Invalid attribute combination",
+		fmt.Sprintf("%s Received '%s'.", v.Description(ctx), other.ValueString()),
+	)
+}
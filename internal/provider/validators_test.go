@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestDescribeValues(t *testing.T) {
+	requireEqual(t, describeValues([]string{}), "")
+	requireEqual(t, describeValues([]string{"A"}), `"A"`)
+	requireEqual(t, describeValues([]string{"A", "B"}), `"A", or "B"`)
+	requireEqual(t, describeValues([]string{"A", "B", "C"}), `"A", "B", or "C"`)
+}
+
+func TestAttributeRequiresValue(t *testing.T) {
+	ctx := t.Context()
+	configSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"trigger_type":  schema.StringAttribute{Required: true},
+		"schedule_cron": schema.StringAttribute{Optional: true},
+	}}
+	configType := configSchema.Type().TerraformType(ctx)
+	validate := func(triggerType, scheduleCron tftypes.Value) *resource.ValidateConfigResponse {
+		req := resource.ValidateConfigRequest{Config: tfsdk.Config{Schema: configSchema, Raw: tftypes.NewValue(configType, map[string]tftypes.Value{
+			"trigger_type":  triggerType,
+			"schedule_cron": scheduleCron,
+		})}}
+		resp := &resource.ValidateConfigResponse{}
+		attributeRequiresValue(path.Root("schedule_cron"), path.Root("trigger_type"), "SCHEDULE").ValidateResource(ctx, req, resp)
+		return resp
+	}
+	cron := tftypes.NewValue(tftypes.String, "0 0 * * 0")
+
+	requireEqual(t, validate(tftypes.NewValue(tftypes.String, "SCHEDULE"), cron).Diagnostics.HasError(), false)
+	requireEqual(t, validate(tftypes.NewValue(tftypes.String, "EVENT"), tftypes.NewValue(tftypes.String, nil)).Diagnostics.HasError(), false)
+	requireEqual(t, validate(tftypes.NewValue(tftypes.String, tftypes.UnknownValue), cron).Diagnostics.HasError(), false)
+
+	resp := validate(tftypes.NewValue(tftypes.String, "EVENT"), cron)
+	requireEqual(t, resp.Diagnostics.HasError(), true)
+	requireEqual(t, resp.Diagnostics.Errors()[0].Detail(),
+		`Attribute 'schedule_cron' requires 'trigger_type' to be "SCHEDULE". Received 'EVENT'.`)
+}
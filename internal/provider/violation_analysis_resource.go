@@ -0,0 +1,455 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"strings"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &violationAnalysisResource{}
+	_ resource.ResourceWithConfigure   = &violationAnalysisResource{}
+	_ resource.ResourceWithImportState = &violationAnalysisResource{}
+	_ resource.ResourceWithIdentity    = &violationAnalysisResource{}
+)
+
+type (
+	violationAnalysisResource struct {
+		client *dtrack.Client
+		semver *Semver
+	}
+
+	violationAnalysisResourceModel struct {
+		Component       types.String `tfsdk:"component"`
+		PolicyCondition types.String `tfsdk:"policy_condition"`
+		PolicyViolation types.String `tfsdk:"policy_violation"`
+		State           types.String `tfsdk:"state"`
+		Suppressed      types.Bool   `tfsdk:"suppressed"`
+		Comment         types.String `tfsdk:"comment"`
+	}
+)
+
+func NewViolationAnalysisResource() resource.Resource {
+	return &violationAnalysisResource{}
+}
+
+func (*violationAnalysisResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_violation_analysis"
+}
+
+func (*violationAnalysisResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the Analysis of a Policy Violation, being a Policy Condition violated by a Component. " +
+			"Each change appends 'comment' to the audit trail of the Analysis. " +
+			"Destroying this resource resets the Analysis to 'NOT_SET', and unsuppresses the violation.",
+		Attributes: map[string]schema.Attribute{
+			"component": schema.StringAttribute{
+				Description: "UUID for the Component which violates the Policy Condition.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_condition": schema.StringAttribute{
+				Description: "UUID for the Policy Condition which is violated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_violation": schema.StringAttribute{
+				Description: "UUID of the Policy Violation, found from 'component' and 'policy_condition'.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the Analysis. Supports " + describeValues(violationAnalysisStateValues) + ".",
+				Required:    true,
+				Validators:  []validator.String{stringvalidator.OneOf(violationAnalysisStateValues...)},
+			},
+			"suppressed": schema.BoolAttribute{
+				Description: "Whether the Policy Violation is suppressed. Defaults to false.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"comment": schema.StringAttribute{
+				Description: "Comment to append to the audit trail of the Analysis, on each change. " +
+					"Defaults to \"" + DefaultAnalysisComment + "\".",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(DefaultAnalysisComment),
+			},
+		},
+	}
+}
+
+var violationAnalysisIdentity = resourceIdentity{
+	{Name: "component_id", State: path.Root("component"), Description: "UUID of the Component."},
+	{Name: "policy_condition_id", State: path.Root("policy_condition"), Description: "UUID of the Policy Condition."},
+}
+
+func (*violationAnalysisResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = violationAnalysisIdentity.Schema()
+}
+
+func (r *violationAnalysisResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan violationAnalysisResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	componentID, diag := TryParseUUID(plan.Component, LifecycleCreate, path.Root("component"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	conditionID, diag := TryParseUUID(plan.PolicyCondition, LifecycleCreate, path.Root("policy_condition"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Violation Analysis", map[string]any{
+		"component":        componentID.String(),
+		"policy_condition": conditionID.String(),
+		"state":            plan.State.ValueString(),
+	})
+	violation, err := r.findViolation(ctx, componentID, conditionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Create, unable to locate policy violation",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	analysis, err := r.client.ViolationAnalysis.Update(ctx, dtrack.ViolationAnalysisRequest{
+		Component:       componentID,
+		PolicyViolation: violation.UUID,
+		Comment:         plan.Comment.ValueString(),
+		State:           dtrack.ViolationAnalysisState(plan.State.ValueString()),
+		Suppressed:      plan.Suppressed.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Create, unable to create violation analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	plan = violationAnalysisModelFromAnalysis(componentID, conditionID, violation.UUID, analysis, plan.Comment)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(violationAnalysisIdentity.Set(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, "Created Violation Analysis", map[string]any{
+		"component":        plan.Component.ValueString(),
+		"policy_condition": plan.PolicyCondition.ValueString(),
+		"policy_violation": plan.PolicyViolation.ValueString(),
+		"state":            plan.State.ValueString(),
+	})
+}
+
+func (r *violationAnalysisResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(violationAnalysisIdentity.Set(ctx, req.State, resp.Identity)...)
+	var state violationAnalysisResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	componentID, diag := TryParseUUID(state.Component, LifecycleRead, path.Root("component"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	conditionID, diag := TryParseUUID(state.PolicyCondition, LifecycleRead, path.Root("policy_condition"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Violation Analysis", map[string]any{
+		"component":        componentID.String(),
+		"policy_condition": conditionID.String(),
+	})
+	// Violation is located again, as DependencyTrack removes violations which no longer apply.
+	violation, err := r.findViolation(ctx, componentID, conditionID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"component": componentID.String(), "policy_condition": conditionID.String()}) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Read, unable to locate policy violation",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	analysis, err := r.client.ViolationAnalysis.Get(ctx, componentID, violation.UUID)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"component": componentID.String(), "policy_condition": conditionID.String()}) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Read, unable to retrieve violation analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	state = violationAnalysisModelFromAnalysis(componentID, conditionID, violation.UUID, analysis, state.Comment)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Read Violation Analysis", map[string]any{
+		"component":        state.Component.ValueString(),
+		"policy_condition": state.PolicyCondition.ValueString(),
+		"policy_violation": state.PolicyViolation.ValueString(),
+		"state":            state.State.ValueString(),
+	})
+}
+
+func (r *violationAnalysisResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan violationAnalysisResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	componentID, diag := TryParseUUID(plan.Component, LifecycleUpdate, path.Root("component"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	conditionID, diag := TryParseUUID(plan.PolicyCondition, LifecycleUpdate, path.Root("policy_condition"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Violation Analysis", map[string]any{
+		"component":        componentID.String(),
+		"policy_condition": conditionID.String(),
+		"state":            plan.State.ValueString(),
+	})
+	violation, err := r.findViolation(ctx, componentID, conditionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Update, unable to locate policy violation",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	analysis, err := r.client.ViolationAnalysis.Update(ctx, dtrack.ViolationAnalysisRequest{
+		Component:       componentID,
+		PolicyViolation: violation.UUID,
+		Comment:         plan.Comment.ValueString(),
+		State:           dtrack.ViolationAnalysisState(plan.State.ValueString()),
+		Suppressed:      plan.Suppressed.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Update, unable to update violation analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	plan = violationAnalysisModelFromAnalysis(componentID, conditionID, violation.UUID, analysis, plan.Comment)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(violationAnalysisIdentity.Set(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, "Updated Violation Analysis", map[string]any{
+		"component":        plan.Component.ValueString(),
+		"policy_condition": plan.PolicyCondition.ValueString(),
+		"policy_violation": plan.PolicyViolation.ValueString(),
+		"state":            plan.State.ValueString(),
+	})
+}
+
+func (r *violationAnalysisResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state violationAnalysisResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	componentID, diag := TryParseUUID(state.Component, LifecycleDelete, path.Root("component"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	conditionID, diag := TryParseUUID(state.PolicyCondition, LifecycleDelete, path.Root("policy_condition"))
+	if diag != nil {
+		resp.Diagnostics.Append(diag)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Violation Analysis", map[string]any{
+		"component":        componentID.String(),
+		"policy_condition": conditionID.String(),
+	})
+	violation, err := r.findViolation(ctx, componentID, conditionID)
+	if IsNotFound(err) {
+		// Violation no longer applies, so there is no analysis to reset.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Delete, unable to locate policy violation",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	// DependencyTrack does not support deleting an Analysis, so it is reset instead.
+	suppressed := false
+	_, err = r.client.ViolationAnalysis.Update(ctx, dtrack.ViolationAnalysisRequest{
+		Component:       componentID,
+		PolicyViolation: violation.UUID,
+		Comment:         "Removed from Terraform.",
+		State:           dtrack.ViolationAnalysisStateNotSet,
+		Suppressed:      &suppressed,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Delete, unable to reset violation analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted Violation Analysis", map[string]any{
+		"component":        state.Component.ValueString(),
+		"policy_condition": state.PolicyCondition.ValueString(),
+	})
+}
+
+func (r *violationAnalysisResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := violationAnalysisIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	idParts := strings.Split(importID, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected import id",
+			"Expected id in format <ComponentID>/<PolicyConditionID>. Received "+importID,
+		)
+		return
+	}
+	componentID, err := uuid.Parse(idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to parse ComponentID as UUID",
+			"Error from: "+err.Error(),
+		)
+	}
+	conditionID, err := uuid.Parse(idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to parse PolicyConditionID as UUID",
+			"Error from: "+err.Error(),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	violation, err := r.findViolation(ctx, componentID, conditionID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to locate policy violation",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	analysis, err := r.client.ViolationAnalysis.Get(ctx, componentID, violation.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to retrieve violation analysis",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	state := violationAnalysisModelFromAnalysis(componentID, conditionID, violation.UUID, analysis, types.StringValue(DefaultAnalysisComment))
+
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Imported Violation Analysis", map[string]any{
+		"component":        state.Component.ValueString(),
+		"policy_condition": state.PolicyCondition.ValueString(),
+		"policy_violation": state.PolicyViolation.ValueString(),
+		"state":            state.State.ValueString(),
+	})
+}
+
+func (r *violationAnalysisResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.semver = clientInfoData.semver
+}
+
+// Locates the Policy Violation of the Component for the Policy Condition, including suppressed violations.
+func (r *violationAnalysisResource) findViolation(ctx context.Context, componentID, conditionID uuid.UUID) (*dtrack.PolicyViolation, error) {
+	return FindPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+		return r.client.PolicyViolation.GetAllForComponent(ctx, componentID, true, po)
+	}, func(violation dtrack.PolicyViolation) bool {
+		return violation.PolicyCondition != nil && violation.PolicyCondition.UUID == conditionID
+	})
+}
+
+func violationAnalysisModelFromAnalysis(componentID, conditionID, violationID uuid.UUID, analysis dtrack.ViolationAnalysis, comment types.String) violationAnalysisResourceModel {
+	return violationAnalysisResourceModel{
+		Component:       types.StringValue(componentID.String()),
+		PolicyCondition: types.StringValue(conditionID.String()),
+		PolicyViolation: types.StringValue(violationID.String()),
+		// DependencyTrack omits unset values, rather than returning 'NOT_SET'.
+		State:      types.StringValue(cmp.Or(string(analysis.State), string(dtrack.ViolationAnalysisStateNotSet))),
+		Suppressed: types.BoolValue(analysis.Suppressed),
+		Comment:    comment,
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccViolationAnalysisResource(t *testing.T) {
+	base := providerConfig + `
+resource "dependencytrack_policy" "test" {
+	name = "Test_Violation_Analysis_Policy"
+	operator = "ANY"
+	violation = "FAIL"
+}
+resource "dependencytrack_policy_condition" "test" {
+	policy = dependencytrack_policy.test.id
+	subject = "COORDINATES"
+	operator = "MATCHES"
+	value = jsonencode({ group = "*", name = "Test_Violation_Analysis_Component", version = "*" })
+}
+resource "dependencytrack_project" "test" {
+	name = "Test_Violation_Analysis_Project"
+}
+resource "dependencytrack_component" "test" {
+	project = dependencytrack_project.test.id
+	name = "Test_Violation_Analysis_Component"
+	version = "1.0.0"
+}
+`
+	// Attributes of the Project, Component and Policy Condition, to evaluate the policy outside of Terraform.
+	var project, component, condition string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: base,
+				Check: func(state *terraform.State) error {
+					resources := state.RootModule().Resources
+					project = resources["dependencytrack_project.test"].Primary.ID
+					component = resources["dependencytrack_component.test"].Primary.ID
+					condition = resources["dependencytrack_policy_condition.test"].Primary.ID
+					return nil
+				},
+			},
+			// Create and Read testing.
+			{
+				PreConfig: func() {
+					testAccWaitForViolation(t, project, component, condition)
+				},
+				Config: base + `
+resource "dependencytrack_violation_analysis" "test" {
+	component = dependencytrack_component.test.id
+	policy_condition = dependencytrack_policy_condition.test.id
+	state = "APPROVED"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_violation_analysis.test", "component",
+						"dependencytrack_component.test", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_violation_analysis.test", "policy_condition",
+						"dependencytrack_policy_condition.test", "id",
+					),
+					resource.TestCheckResourceAttrSet("dependencytrack_violation_analysis.test", "policy_violation"),
+					resource.TestCheckResourceAttr("dependencytrack_violation_analysis.test", "state", "APPROVED"),
+					resource.TestCheckResourceAttr("dependencytrack_violation_analysis.test", "suppressed", "false"),
+					resource.TestCheckResourceAttr("dependencytrack_violation_analysis.test", "comment", DefaultAnalysisComment),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName: "dependencytrack_violation_analysis.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attributes := state.RootModule().Resources["dependencytrack_violation_analysis.test"].Primary.Attributes
+					return attributes["component"] + "/" + attributes["policy_condition"], nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "policy_condition",
+			},
+			// Update and Read testing.
+			{
+				Config: base + `
+resource "dependencytrack_violation_analysis" "test" {
+	component = dependencytrack_component.test.id
+	policy_condition = dependencytrack_policy_condition.test.id
+	state = "REJECTED"
+	suppressed = true
+	comment = "Rejected."
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_violation_analysis.test", "state", "REJECTED"),
+					resource.TestCheckResourceAttr("dependencytrack_violation_analysis.test", "suppressed", "true"),
+					resource.TestCheckResourceAttr("dependencytrack_violation_analysis.test", "comment", "Rejected."),
+				),
+			},
+		},
+	})
+}
+
+// Evaluates the policies for the Project, outside of Terraform, waiting until the Component violates the Policy Condition.
+func testAccWaitForViolation(t *testing.T, projectID, componentID, conditionID string) {
+	t.Helper()
+	client := testAccClient(t)
+	_, err := client.Finding.AnalyzeProject(t.Context(), uuid.MustParse(projectID))
+	requireNoError(t, err)
+	deadline := time.Now().Add(DefaultUploadTimeout)
+	for {
+		var violations []dtrack.PolicyViolation
+		violations, err = FilterPaged(func(po dtrack.PageOptions) (dtrack.Page[dtrack.PolicyViolation], error) {
+			return client.PolicyViolation.GetAllForComponent(t.Context(), uuid.MustParse(componentID), true, po)
+		}, func(violation dtrack.PolicyViolation) bool {
+			return violation.PolicyCondition != nil && violation.PolicyCondition.UUID.String() == conditionID
+		})
+		requireNoError(t, err)
+		if len(violations) > 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Component '%s' did not violate Policy Condition '%s' within %s", componentID, conditionID, DefaultUploadTimeout)
+		}
+		time.Sleep(uploadPollInterval)
+	}
+}
+
+func TestViolationAnalysisModelFromAnalysis(t *testing.T) {
+	componentID := uuid.MustParse("c82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	conditionID := uuid.MustParse("d82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	violationID := uuid.MustParse("e82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	{
+		model := violationAnalysisModelFromAnalysis(componentID, conditionID, violationID, dtrack.ViolationAnalysis{
+			Comments:   nil,
+			State:      dtrack.ViolationAnalysisStateApproved,
+			Suppressed: true,
+		}, types.StringValue("Comment"))
+		requireEqual(t, model.Component.ValueString(), componentID.String())
+		requireEqual(t, model.PolicyCondition.ValueString(), conditionID.String())
+		requireEqual(t, model.PolicyViolation.ValueString(), violationID.String())
+		requireEqual(t, model.State.ValueString(), "APPROVED")
+		requireEqual(t, model.Suppressed.ValueBool(), true)
+		requireEqual(t, model.Comment.ValueString(), "Comment")
+	}
+	{
+		model := violationAnalysisModelFromAnalysis(componentID, conditionID, violationID, dtrack.ViolationAnalysis{}, types.StringValue(DefaultAnalysisComment))
+		requireEqual(t, model.State.ValueString(), "NOT_SET")
+		requireEqual(t, model.Suppressed.ValueBool(), false)
+		requireEqual(t, model.Comment.ValueString(), DefaultAnalysisComment)
+	}
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Interface impl check.
+var (
+	_ datasource.DataSource              = &vulnerabilityDataSource{}
+	_ datasource.DataSourceWithConfigure = &vulnerabilityDataSource{}
+)
+
+type vulnerabilityDataSource struct {
+	client *dtrack.Client
+	api    apiClient
+	semver *Semver
+}
+
+func NewVulnerabilityDataSource() datasource.DataSource {
+	return &vulnerabilityDataSource{}
+}
+
+func (*vulnerabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vulnerability"
+}
+
+func (*vulnerabilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetch a Vulnerability by source and ID, such as an internal Vulnerability, or one mirrored from NVD.",
+		Attributes: map[string]schema.Attribute{
+			"source": schema.StringAttribute{
+				Description: "Source of the Vulnerability, such as \"" + VulnerabilitySourceInternal + "\", \"NVD\", or \"GITHUB\".",
+				Required:    true,
+			},
+			"vuln_id": schema.StringAttribute{
+				Description: "Identifier of the Vulnerability within the source, such as `CVE-2021-44228`.",
+				Required:    true,
+			},
+			"id": schema.StringAttribute{
+				Description: "UUID of the Vulnerability.",
+				Computed:    true,
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the Vulnerability.",
+				Computed:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Vulnerability.",
+				Computed:    true,
+			},
+			"recommendation": schema.StringAttribute{
+				Description: "Recommendation for remediating the Vulnerability.",
+				Computed:    true,
+			},
+			"references": schema.StringAttribute{
+				Description: "References for the Vulnerability, in Markdown.",
+				Computed:    true,
+			},
+			"severity": schema.StringAttribute{
+				Description: "Severity of the Vulnerability.",
+				Computed:    true,
+			},
+			"cvss_v2_vector": schema.StringAttribute{
+				Description: "CVSSv2 vector of the Vulnerability.",
+				Computed:    true,
+			},
+			"cvss_v2_score": schema.Float64Attribute{
+				Description: "CVSSv2 base score of the Vulnerability.",
+				Computed:    true,
+			},
+			"cvss_v3_vector": schema.StringAttribute{
+				Description: "CVSSv3 vector of the Vulnerability.",
+				Computed:    true,
+			},
+			"cvss_v3_score": schema.Float64Attribute{
+				Description: "CVSSv3 base score of the Vulnerability.",
+				Computed:    true,
+			},
+			"cwes": schema.SetAttribute{
+				Description: "IDs of the CWEs of the Vulnerability.",
+				Computed:    true,
+				ElementType: types.Int64Type,
+			},
+			"affected_components": schema.SetNestedAttribute{
+				Description: "Components affected by the Vulnerability, identified by PURL or CPE.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of 'identity', being \"PURL\" or \"CPE\".",
+							Computed:    true,
+						},
+						"identity": schema.StringAttribute{
+							Description: "PURL or CPE of the affected Component.",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Exact version which is affected.",
+							Computed:    true,
+						},
+						"version_start_including": schema.StringAttribute{
+							Description: "Lowest version which is affected.",
+							Computed:    true,
+						},
+						"version_start_excluding": schema.StringAttribute{
+							Description: "Highest version which is not affected, below the affected range.",
+							Computed:    true,
+						},
+						"version_end_including": schema.StringAttribute{
+							Description: "Highest version which is affected.",
+							Computed:    true,
+						},
+						"version_end_excluding": schema.StringAttribute{
+							Description: "Lowest version which is not affected, above the affected range.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *vulnerabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state vulnerabilityResourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Reading DependencyTrack Vulnerability", map[string]any{
+		"source":  state.Source.ValueString(),
+		"vuln_id": state.VulnID.ValueString(),
+	})
+	vulnerability, err := d.api.GetVulnerabilityBySource(ctx, state.Source.ValueString(), state.VulnID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Read, unable to retrieve Vulnerability.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	vulnerabilityState := vulnerabilityModelFromAPI(ctx, vulnerability, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Retain the configured values, as DependencyTrack may differ in case.
+	vulnerabilityState.Source = state.Source
+	vulnerabilityState.VulnID = state.VulnID
+
+	diags = resp.State.Set(ctx, &vulnerabilityState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Read Vulnerability", map[string]any{
+		"id":       vulnerabilityState.ID.ValueString(),
+		"source":   vulnerabilityState.Source.ValueString(),
+		"vuln_id":  vulnerabilityState.VulnID.ValueString(),
+		"severity": vulnerabilityState.Severity.ValueString(),
+	})
+}
+
+func (d *vulnerabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.client = clientInfoData.client
+	d.api = clientInfoData.api
+	d.semver = clientInfoData.semver
+}
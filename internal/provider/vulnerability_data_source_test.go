@@ -0,0 +1,41 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVulnerabilityDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id = "INT-TEST-DATA-001"
+	title = "Test_Vulnerability_Data"
+	severity = "HIGH"
+	cwes = [89]
+}
+data "dependencytrack_vulnerability" "test" {
+	source = dependencytrack_vulnerability.test.source
+	vuln_id = dependencytrack_vulnerability.test.vuln_id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.dependencytrack_vulnerability.test", "id",
+						"dependencytrack_vulnerability.test", "id",
+					),
+					resource.TestCheckResourceAttr("data.dependencytrack_vulnerability.test", "source", "INTERNAL"),
+					resource.TestCheckResourceAttr("data.dependencytrack_vulnerability.test", "vuln_id", "INT-TEST-DATA-001"),
+					resource.TestCheckResourceAttr("data.dependencytrack_vulnerability.test", "title", "Test_Vulnerability_Data"),
+					resource.TestCheckResourceAttr("data.dependencytrack_vulnerability.test", "severity", "HIGH"),
+					resource.TestCheckResourceAttr("data.dependencytrack_vulnerability.test", "cwes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("data.dependencytrack_vulnerability.test", "cwes.*", "89"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,567 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ resource.Resource                = &vulnerabilityResource{}
+	_ resource.ResourceWithConfigure   = &vulnerabilityResource{}
+	_ resource.ResourceWithImportState = &vulnerabilityResource{}
+	_ resource.ResourceWithIdentity    = &vulnerabilityResource{}
+
+	vulnerabilityAffectedComponentType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"type":                    types.StringType,
+		"identity":                types.StringType,
+		"version":                 types.StringType,
+		"version_start_including": types.StringType,
+		"version_start_excluding": types.StringType,
+		"version_end_including":   types.StringType,
+		"version_end_excluding":   types.StringType,
+	}}
+)
+
+type (
+	vulnerabilityResource struct {
+		client *dtrack.Client
+		api    apiClient
+		semver *Semver
+	}
+
+	// Also the model of the data source, with all attributes computed.
+	vulnerabilityResourceModel struct {
+		ID                 types.String  `tfsdk:"id"`
+		VulnID             types.String  `tfsdk:"vuln_id"`
+		Source             types.String  `tfsdk:"source"`
+		Title              types.String  `tfsdk:"title"`
+		Description        types.String  `tfsdk:"description"`
+		Recommendation     types.String  `tfsdk:"recommendation"`
+		References         types.String  `tfsdk:"references"`
+		Severity           types.String  `tfsdk:"severity"`
+		CVSSV2Vector       types.String  `tfsdk:"cvss_v2_vector"`
+		CVSSV2Score        types.Float64 `tfsdk:"cvss_v2_score"`
+		CVSSV3Vector       types.String  `tfsdk:"cvss_v3_vector"`
+		CVSSV3Score        types.Float64 `tfsdk:"cvss_v3_score"`
+		CWEs               types.Set     `tfsdk:"cwes"`
+		AffectedComponents types.Set     `tfsdk:"affected_components"`
+	}
+
+	vulnerabilityAffectedComponentModel struct {
+		Type                  types.String `tfsdk:"type"`
+		Identity              types.String `tfsdk:"identity"`
+		Version               types.String `tfsdk:"version"`
+		VersionStartIncluding types.String `tfsdk:"version_start_including"`
+		VersionStartExcluding types.String `tfsdk:"version_start_excluding"`
+		VersionEndIncluding   types.String `tfsdk:"version_end_including"`
+		VersionEndExcluding   types.String `tfsdk:"version_end_excluding"`
+	}
+)
+
+func NewVulnerabilityResource() resource.Resource {
+	return &vulnerabilityResource{}
+}
+
+func (*vulnerabilityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vulnerability"
+}
+
+func (*vulnerabilityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	versionRanges := []string{"version_start_including", "version_start_excluding", "version_end_including", "version_end_excluding"}
+	siblings := func(names ...string) []path.Expression {
+		return Map(names, func(name string) path.Expression { return path.MatchRelative().AtParent().AtName(name) })
+	}
+	resp.Schema = schema.Schema{
+		Description: "Manages an internal Vulnerability, such as an advisory for an in-house library. " +
+			"Requires the `VULNERABILITY_MANAGEMENT` permission.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "UUID for the Vulnerability as generated by DependencyTrack.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vuln_id": schema.StringAttribute{
+				Description: "Identifier of the Vulnerability, unique within the internal source. " +
+					"Generated by DependencyTrack, such as `INT-1234-5678`, when not provided.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"source": schema.StringAttribute{
+				Description: "Source of the Vulnerability, being \"" + VulnerabilitySourceInternal + "\".",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: "Title of the Vulnerability.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Vulnerability.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"recommendation": schema.StringAttribute{
+				Description: "Recommendation for remediating the Vulnerability.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"references": schema.StringAttribute{
+				Description: "References for the Vulnerability, in Markdown.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"severity": schema.StringAttribute{
+				Description: "Severity of the Vulnerability. Supports " + describeValues(vulnerabilitySeverityValues) + ". " +
+					"When not provided, DependencyTrack derives the severity from the CVSS scores.",
+				Optional:   true,
+				Computed:   true,
+				Validators: []validator.String{stringvalidator.OneOf(vulnerabilitySeverityValues...)},
+			},
+			"cvss_v2_vector": schema.StringAttribute{
+				Description: "CVSSv2 vector of the Vulnerability, such as `AV:N/AC:L/Au:N/C:P/I:P/A:P`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"cvss_v2_score": schema.Float64Attribute{
+				Description: "CVSSv2 base score, computed by DependencyTrack from 'cvss_v2_vector'.",
+				Computed:    true,
+			},
+			"cvss_v3_vector": schema.StringAttribute{
+				Description: "CVSSv3 vector of the Vulnerability, such as `CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H`.",
+				Optional:    true,
+				Validators:  []validator.String{stringvalidator.LengthAtLeast(1)},
+			},
+			"cvss_v3_score": schema.Float64Attribute{
+				Description: "CVSSv3 base score, computed by DependencyTrack from 'cvss_v3_vector'.",
+				Computed:    true,
+			},
+			"cwes": schema.SetAttribute{
+				Description: "IDs of the CWEs of the Vulnerability, such as `79`.",
+				Optional:    true,
+				ElementType: types.Int64Type,
+				Validators:  []validator.Set{setvalidator.SizeAtLeast(1)},
+			},
+			"affected_components": schema.SetNestedAttribute{
+				Description: "Components affected by the Vulnerability, identified by PURL or CPE, " +
+					"with either an exact 'version', or a range of versions.",
+				Optional:   true,
+				Validators: []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Description: "Type of 'identity'. Supports " + describeValues(affectedIdentityTypeValues) + ".",
+							Required:    true,
+							Validators:  []validator.String{stringvalidator.OneOf(affectedIdentityTypeValues...)},
+						},
+						"identity": schema.StringAttribute{
+							Description: "PURL or CPE of the affected Component.",
+							Required:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "Exact version which is affected.",
+							Optional:    true,
+							Validators:  []validator.String{stringvalidator.ConflictsWith(siblings(versionRanges...)...)},
+						},
+						"version_start_including": schema.StringAttribute{
+							Description: "Lowest version which is affected.",
+							Optional:    true,
+							Validators:  []validator.String{stringvalidator.ConflictsWith(siblings("version_start_excluding")...)},
+						},
+						"version_start_excluding": schema.StringAttribute{
+							Description: "Highest version which is not affected, below the affected range.",
+							Optional:    true,
+						},
+						"version_end_including": schema.StringAttribute{
+							Description: "Highest version which is affected.",
+							Optional:    true,
+							Validators:  []validator.String{stringvalidator.ConflictsWith(siblings("version_end_excluding")...)},
+						},
+						"version_end_excluding": schema.StringAttribute{
+							Description: "Lowest version which is not affected, above the affected range, such as the fixed version.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var vulnerabilityIdentity = uuidIdentity("UUID of the Vulnerability.")
+
+func (*vulnerabilityResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vulnerabilityIdentity.Schema()
+}
+
+func (r *vulnerabilityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vulnerabilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vulnerabilityReq := vulnerabilityRequestFromModel(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if vulnerabilityReq.VulnID == "" {
+		// DependencyTrack requires an identifier, so one is generated, as within its UI.
+		vulnID, err := r.api.GenerateVulnerabilityID(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Within Create, unable to generate vulnerability identifier",
+				"Error from: "+err.Error(),
+			)
+			return
+		}
+		vulnerabilityReq.VulnID = vulnID
+	}
+
+	tflog.Debug(ctx, "Creating Vulnerability", map[string]any{
+		"vuln_id": vulnerabilityReq.VulnID,
+		"title":   vulnerabilityReq.Title,
+	})
+	created, err := r.api.CreateVulnerability(ctx, vulnerabilityReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Create, unable to create vulnerability",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	if created.UUID == nil {
+		resp.Diagnostics.AddError(
+			"Within Create, unable to identify created vulnerability",
+			"DependencyTrack did not return a UUID for vulnerability '"+created.VulnID+"'.",
+		)
+		return
+	}
+	// Retrieved again, as affected components are only populated when fetched.
+	vulnerability, err := r.api.GetVulnerability(ctx, *created.UUID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Create, unable to retrieve created vulnerability",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	plan = vulnerabilityModelFromAPI(ctx, vulnerability, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(vulnerabilityIdentity.Set(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, "Created Vulnerability", map[string]any{
+		"id":       plan.ID.ValueString(),
+		"vuln_id":  plan.VulnID.ValueString(),
+		"severity": plan.Severity.ValueString(),
+	})
+}
+
+func (r *vulnerabilityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	resp.Diagnostics.Append(vulnerabilityIdentity.Set(ctx, req.State, resp.Identity)...)
+	var state vulnerabilityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diagnostic := TryParseUUID(state.ID, LifecycleRead, path.Root("id"))
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	tflog.Debug(ctx, "Reading Vulnerability", map[string]any{
+		"id":      id.String(),
+		"vuln_id": state.VulnID.ValueString(),
+	})
+	vulnerability, err := r.api.GetVulnerability(ctx, id)
+	if RemoveIfNotFound(ctx, err, &resp.State, map[string]any{"id": id.String()}) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Read, unable to retrieve vulnerability",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	state = vulnerabilityModelFromAPI(ctx, vulnerability, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Read Vulnerability", map[string]any{
+		"id":       state.ID.ValueString(),
+		"vuln_id":  state.VulnID.ValueString(),
+		"severity": state.Severity.ValueString(),
+	})
+}
+
+func (r *vulnerabilityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan vulnerabilityResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diagnostic := TryParseUUID(plan.ID, LifecycleUpdate, path.Root("id"))
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+	vulnerabilityReq := vulnerabilityRequestFromModel(ctx, plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	vulnerabilityReq.UUID = &id
+
+	tflog.Debug(ctx, "Updating Vulnerability", map[string]any{
+		"id":      id.String(),
+		"vuln_id": vulnerabilityReq.VulnID,
+		"title":   vulnerabilityReq.Title,
+	})
+	_, err := r.api.UpdateVulnerability(ctx, vulnerabilityReq)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Update, unable to update vulnerability",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	vulnerability, err := r.api.GetVulnerability(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Update, unable to retrieve updated vulnerability",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	plan = vulnerabilityModelFromAPI(ctx, vulnerability, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(vulnerabilityIdentity.Set(ctx, resp.State, resp.Identity)...)
+	tflog.Debug(ctx, "Updated Vulnerability", map[string]any{
+		"id":       plan.ID.ValueString(),
+		"vuln_id":  plan.VulnID.ValueString(),
+		"severity": plan.Severity.ValueString(),
+	})
+}
+
+func (r *vulnerabilityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vulnerabilityResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id, diagnostic := TryParseUUID(state.ID, LifecycleDelete, path.Root("id"))
+	if diagnostic != nil {
+		resp.Diagnostics.Append(diagnostic)
+		return
+	}
+
+	tflog.Debug(ctx, "Deleting Vulnerability", map[string]any{
+		"id":      id.String(),
+		"vuln_id": state.VulnID.ValueString(),
+	})
+	err := r.api.DeleteVulnerability(ctx, id)
+	if err != nil && !IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Within Delete, unable to delete vulnerability",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	tflog.Debug(ctx, "Deleted Vulnerability", map[string]any{
+		"id":      state.ID.ValueString(),
+		"vuln_id": state.VulnID.ValueString(),
+	})
+}
+
+func (r *vulnerabilityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importID, idDiags := vulnerabilityIdentity.ImportID(ctx, req)
+	resp.Diagnostics.Append(idDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Importing Vulnerability", map[string]any{
+		"id": importID,
+	})
+	id, err := ResolveImportID(importID, "<VulnID>", func(key string) ([]uuid.UUID, error) {
+		vulnerability, err := r.api.GetVulnerabilityBySource(ctx, VulnerabilitySourceInternal, key)
+		if IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if vulnerability.UUID == nil {
+			return nil, nil
+		}
+		return []uuid.UUID{*vulnerability.UUID}, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Within Import, unable to resolve vulnerability.",
+			"Error from: "+err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id.String())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Debug(ctx, "Imported Vulnerability", map[string]any{
+		"id": id.String(),
+	})
+}
+
+func (r *vulnerabilityResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	clientInfoData, ok := req.ProviderData.(clientInfo)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Configure Type",
+			fmt.Sprintf("Expected provider.clientInfo, got %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = clientInfoData.client
+	r.api = clientInfoData.api
+	r.semver = clientInfoData.semver
+}
+
+func vulnerabilityRequestFromModel(ctx context.Context, model vulnerabilityResourceModel, diagnostics *diag.Diagnostics) apiVulnerability {
+	var cweIDs []int64
+	diagnostics.Append(model.CWEs.ElementsAs(ctx, &cweIDs, false)...)
+	var affected []vulnerabilityAffectedComponentModel
+	diagnostics.Append(model.AffectedComponents.ElementsAs(ctx, &affected, false)...)
+	return apiVulnerability{
+		UUID:           nil,
+		VulnID:         model.VulnID.ValueString(),
+		Source:         VulnerabilitySourceInternal,
+		Title:          model.Title.ValueString(),
+		Description:    model.Description.ValueString(),
+		Recommendation: model.Recommendation.ValueString(),
+		References:     model.References.ValueString(),
+		// Unknown when not configured, so that DependencyTrack derives it from the CVSS scores.
+		Severity:        model.Severity.ValueString(),
+		CVSSV2Vector:    model.CVSSV2Vector.ValueString(),
+		CVSSV2BaseScore: nil,
+		CVSSV3Vector:    model.CVSSV3Vector.ValueString(),
+		CVSSV3BaseScore: nil,
+		// Always sent, even when empty, so that removed values are cleared within DependencyTrack.
+		CWEs: Map(cweIDs, func(id int64) dtrack.CWE { return dtrack.CWE{ID: int(id), Name: ""} }),
+		AffectedComponents: Map(affected, func(component vulnerabilityAffectedComponentModel) apiAffectedComponent {
+			versionType := "RANGE"
+			if component.Version.ValueString() != "" {
+				versionType = "EXACT"
+			}
+			return apiAffectedComponent{
+				IdentityType:          component.Type.ValueString(),
+				Identity:              component.Identity.ValueString(),
+				VersionType:           versionType,
+				Version:               component.Version.ValueString(),
+				VersionStartIncluding: component.VersionStartIncluding.ValueString(),
+				VersionStartExcluding: component.VersionStartExcluding.ValueString(),
+				VersionEndIncluding:   component.VersionEndIncluding.ValueString(),
+				VersionEndExcluding:   component.VersionEndExcluding.ValueString(),
+			}
+		}),
+		Aliases: nil,
+	}
+}
+
+// Converts a Vulnerability from DependencyTrack, with absent values as null.
+func vulnerabilityModelFromAPI(ctx context.Context, vulnerability apiVulnerability, diagnostics *diag.Diagnostics) vulnerabilityResourceModel {
+	id := types.StringNull()
+	if vulnerability.UUID != nil {
+		id = types.StringValue(vulnerability.UUID.String())
+	}
+	cwes := types.SetNull(types.Int64Type)
+	if len(vulnerability.CWEs) > 0 {
+		value, diags := types.SetValueFrom(ctx, types.Int64Type, Map(vulnerability.CWEs, func(cwe dtrack.CWE) int64 { return int64(cwe.ID) }))
+		diagnostics.Append(diags...)
+		cwes = value
+	}
+	affected := types.SetNull(vulnerabilityAffectedComponentType)
+	if len(vulnerability.AffectedComponents) > 0 {
+		value, diags := types.SetValueFrom(ctx, vulnerabilityAffectedComponentType, Map(vulnerability.AffectedComponents, func(component apiAffectedComponent) vulnerabilityAffectedComponentModel {
+			return vulnerabilityAffectedComponentModel{
+				Type:                  types.StringValue(component.IdentityType),
+				Identity:              types.StringValue(component.Identity),
+				Version:               stringOrNull(component.Version),
+				VersionStartIncluding: stringOrNull(component.VersionStartIncluding),
+				VersionStartExcluding: stringOrNull(component.VersionStartExcluding),
+				VersionEndIncluding:   stringOrNull(component.VersionEndIncluding),
+				VersionEndExcluding:   stringOrNull(component.VersionEndExcluding),
+			}
+		}))
+		diagnostics.Append(diags...)
+		affected = value
+	}
+	return vulnerabilityResourceModel{
+		ID:                 id,
+		VulnID:             types.StringValue(vulnerability.VulnID),
+		Source:             types.StringValue(vulnerability.Source),
+		Title:              stringOrNull(vulnerability.Title),
+		Description:        stringOrNull(vulnerability.Description),
+		Recommendation:     stringOrNull(vulnerability.Recommendation),
+		References:         stringOrNull(vulnerability.References),
+		Severity:           types.StringValue(vulnerability.Severity),
+		CVSSV2Vector:       stringOrNull(vulnerability.CVSSV2Vector),
+		CVSSV2Score:        types.Float64PointerValue(vulnerability.CVSSV2BaseScore),
+		CVSSV3Vector:       stringOrNull(vulnerability.CVSSV3Vector),
+		CVSSV3Score:        types.Float64PointerValue(vulnerability.CVSSV3BaseScore),
+		CWEs:               cwes,
+		AffectedComponents: affected,
+	}
+}
@@ -0,0 +1,310 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	dtrack "github.com/DependencyTrack/client-go"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVulnerabilityResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: providerConfig + `
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id = "INT-TEST-001"
+	title = "Test_Vulnerability"
+	description = "Description"
+	cvss_v3_vector = "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H"
+	cwes = [79]
+	affected_components = [{
+		type = "PURL"
+		identity = "pkg:maven/com.example/test-library"
+		version_end_excluding = "1.2.0"
+	}]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dependencytrack_vulnerability.test", "id"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "vuln_id", "INT-TEST-001"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "source", "INTERNAL"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "title", "Test_Vulnerability"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "severity", "CRITICAL"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "cvss_v3_score", "9.8"),
+					resource.TestCheckNoResourceAttr("dependencytrack_vulnerability.test", "cvss_v2_score"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "cwes.#", "1"),
+					resource.TestCheckTypeSetElemAttr("dependencytrack_vulnerability.test", "cwes.*", "79"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "affected_components.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("dependencytrack_vulnerability.test", "affected_components.*", map[string]string{
+						"type":                  "PURL",
+						"identity":              "pkg:maven/com.example/test-library",
+						"version_end_excluding": "1.2.0",
+					}),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "dependencytrack_vulnerability.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "dependencytrack_vulnerability.test",
+				ImportState:       true,
+				ImportStateId:     "INT-TEST-001",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing.
+			{
+				Config: providerConfig + `
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id = "INT-TEST-001"
+	title = "Test_Vulnerability_2"
+	severity = "LOW"
+	affected_components = [{
+		type = "CPE"
+		identity = "cpe:2.3:a:example:test-library:*:*:*:*:*:*:*:*"
+		version = "1.0.0"
+	}]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dependencytrack_vulnerability.test", "id"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "title", "Test_Vulnerability_2"),
+					resource.TestCheckNoResourceAttr("dependencytrack_vulnerability.test", "description"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "severity", "LOW"),
+					resource.TestCheckNoResourceAttr("dependencytrack_vulnerability.test", "cvss_v3_vector"),
+					resource.TestCheckNoResourceAttr("dependencytrack_vulnerability.test", "cwes"),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "affected_components.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("dependencytrack_vulnerability.test", "affected_components.*", map[string]string{
+						"type":    "CPE",
+						"version": "1.0.0",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVulnerabilityResourceGeneratedID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "dependencytrack_vulnerability" "test" {
+	title = "Test_Vulnerability_Generated"
+	severity = "LOW"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("dependencytrack_vulnerability.test", "id"),
+					resource.TestMatchResourceAttr("dependencytrack_vulnerability.test", "vuln_id", regexp.MustCompile(`^INT-`)),
+					resource.TestCheckResourceAttr("dependencytrack_vulnerability.test", "source", "INTERNAL"),
+				),
+			},
+			{
+				ResourceName:      "dependencytrack_vulnerability.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// Analyses of findings for internal vulnerabilities, as they are not found within the mirrored sources.
+func TestAccVulnerabilityResourceAnalysis(t *testing.T) {
+	base := providerConfig + `
+resource "dependencytrack_project" "test" {
+	name = "Test_Analysis_Project"
+}
+resource "dependencytrack_component" "test" {
+	project = dependencytrack_project.test.id
+	name = "Test_Analysis_Component"
+	version = "1.0.0"
+	purl = "pkg:maven/com.example/test-analysis@1.0.0"
+}
+resource "dependencytrack_vulnerability" "test" {
+	vuln_id = "INT-TEST-ANALYSIS-001"
+	title = "Test_Analysis_Vulnerability"
+	severity = "HIGH"
+}
+`
+	updated := base + `
+resource "dependencytrack_analysis" "test" {
+	project = dependencytrack_project.test.id
+	component = dependencytrack_component.test.id
+	vulnerability = dependencytrack_vulnerability.test.id
+	state = "EXPLOITABLE"
+	response = "UPDATE"
+	comment = "Reassessed."
+}
+`
+	// Attributes of the analysis, once updated, to change outside of Terraform.
+	var attributes map[string]string
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing.
+			{
+				Config: base + `
+resource "dependencytrack_analysis" "test" {
+	project = dependencytrack_project.test.id
+	component = dependencytrack_component.test.id
+	vulnerability = dependencytrack_vulnerability.test.id
+	state = "NOT_AFFECTED"
+	justification = "CODE_NOT_REACHABLE"
+	details = "Details"
+	suppressed = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"dependencytrack_analysis.test", "vulnerability",
+						"dependencytrack_vulnerability.test", "id",
+					),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "state", "NOT_AFFECTED"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "justification", "CODE_NOT_REACHABLE"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "response", "NOT_SET"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "details", "Details"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "suppressed", "true"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "comment", DefaultAnalysisComment),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName: "dependencytrack_analysis.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attributes := state.RootModule().Resources["dependencytrack_analysis.test"].Primary.Attributes
+					return attributes["project"] + "/" + attributes["component"] + "/" + attributes["vulnerability"], nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "vulnerability",
+			},
+			// Update and Read testing.
+			{
+				Config: updated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "state", "EXPLOITABLE"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "justification", "NOT_SET"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "response", "UPDATE"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "details", "Details"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "suppressed", "false"),
+					resource.TestCheckResourceAttr("dependencytrack_analysis.test", "comment", "Reassessed."),
+					func(state *terraform.State) error {
+						attributes = state.RootModule().Resources["dependencytrack_analysis.test"].Primary.Attributes
+						return nil
+					},
+				),
+			},
+			// Changes outside of Terraform are detected within Read.
+			{
+				PreConfig: func() {
+					_, err := testAccClient(t).Analysis.Create(t.Context(), dtrack.AnalysisRequest{
+						Project:       uuid.MustParse(attributes["project"]),
+						Component:     uuid.MustParse(attributes["component"]),
+						Vulnerability: uuid.MustParse(attributes["vulnerability"]),
+						Comment:       "Changed outside of Terraform.",
+						State:         dtrack.AnalysisStateFalsePositive,
+						Justification: "",
+						Response:      "",
+						Details:       "",
+						Suppressed:    nil,
+					})
+					requireNoError(t, err)
+				},
+				Config:             updated,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestVulnerabilityModelFromAPI(t *testing.T) {
+	id := uuid.MustParse("c82d6f01-a7a4-41d6-9b03-4f06497f575b")
+	score := 9.8
+	var diags diag.Diagnostics
+	model := vulnerabilityModelFromAPI(t.Context(), apiVulnerability{
+		UUID:            &id,
+		VulnID:          "INT-001",
+		Source:          VulnerabilitySourceInternal,
+		Title:           "Title",
+		Description:     "",
+		Recommendation:  "",
+		References:      "",
+		Severity:        "CRITICAL",
+		CVSSV2Vector:    "",
+		CVSSV2BaseScore: nil,
+		CVSSV3Vector:    "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H",
+		CVSSV3BaseScore: &score,
+		CWEs:            []dtrack.CWE{{ID: 79, Name: "Cross-site Scripting"}},
+		AffectedComponents: []apiAffectedComponent{{
+			IdentityType:          "PURL",
+			Identity:              "pkg:maven/com.example/lib",
+			VersionType:           "RANGE",
+			Version:               "",
+			VersionStartIncluding: "",
+			VersionStartExcluding: "",
+			VersionEndIncluding:   "",
+			VersionEndExcluding:   "1.2.0",
+		}},
+		Aliases: nil,
+	}, &diags)
+	requireEqual(t, diags.HasError(), false)
+	requireEqual(t, model.ID.ValueString(), id.String())
+	requireEqual(t, model.Title.ValueString(), "Title")
+	requireEqual(t, model.Description.IsNull(), true)
+	requireEqual(t, model.CVSSV2Score.IsNull(), true)
+	requireEqual(t, model.CVSSV3Score.ValueFloat64(), 9.8)
+	requireEqual(t, len(model.CWEs.Elements()), 1)
+	requireEqual(t, len(model.AffectedComponents.Elements()), 1)
+
+	req := vulnerabilityRequestFromModel(t.Context(), model, &diags)
+	requireEqual(t, diags.HasError(), false)
+	requireNil(t, req.UUID)
+	requireEqual(t, req.VulnID, "INT-001")
+	requireEqual(t, req.Source, VulnerabilitySourceInternal)
+	requireEqual(t, req.CVSSV3Vector, "CVSS:3.1/AV:N/AC:L/PR:N/UI:N/S:U/C:H/I:H/A:H")
+	requireEqual(t, len(req.CWEs), 1)
+	requireEqual(t, req.CWEs[0].ID, 79)
+	requireEqual(t, len(req.AffectedComponents), 1)
+	requireEqual(t, req.AffectedComponents[0].VersionType, "RANGE")
+	requireEqual(t, req.AffectedComponents[0].VersionEndExcluding, "1.2.0")
+
+	model = vulnerabilityModelFromAPI(t.Context(), apiVulnerability{
+		UUID:               nil,
+		VulnID:             "INT-002",
+		Source:             VulnerabilitySourceInternal,
+		Title:              "",
+		Description:        "",
+		Recommendation:     "",
+		References:         "",
+		Severity:           "UNASSIGNED",
+		CVSSV2Vector:       "",
+		CVSSV2BaseScore:    nil,
+		CVSSV3Vector:       "",
+		CVSSV3BaseScore:    nil,
+		CWEs:               nil,
+		AffectedComponents: nil,
+		Aliases:            nil,
+	}, &diags)
+	requireEqual(t, diags.HasError(), false)
+	requireEqual(t, model.ID.IsNull(), true)
+	requireEqual(t, model.CWEs.IsNull(), true)
+	requireEqual(t, model.AffectedComponents.IsNull(), true)
+
+	req = vulnerabilityRequestFromModel(t.Context(), model, &diags)
+	requireEqual(t, diags.HasError(), false)
+	// Sent as empty rather than omitted, to clear values within DependencyTrack.
+	requireEqual(t, req.CWEs != nil, true)
+	requireEqual(t, req.AffectedComponents != nil, true)
+}